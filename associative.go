@@ -1,5 +1,13 @@
 package util
 
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDuplicateKey is returned by ErrorOnDuplicate when two elements map to the same key.
+var ErrDuplicateKey = errors.New("duplicate key")
+
 // Associative converts a slice to a map using a given function.
 //
 // The convert function f is called on each element of slice s.
@@ -17,6 +25,94 @@ func Associative[E any, S ~[]E, K comparable, V any](s S, f func(E) (K, V)) map[
 	return m
 }
 
+// AssociativeMerge converts a slice to a map like Associative, resolving duplicate keys with merge.
+//
+// When f returns a key that is already present, merge is called with the key, the value currently
+// in the map and the new value. Its result replaces the value in the map. If merge returns an error,
+// conversion stops and the error is returned.
+//
+// KeepFirst, KeepLast and ErrorOnDuplicate are predefined merge functions.
+func AssociativeMerge[E any, S ~[]E, K comparable, V any](
+	s S, f func(E) (K, V), merge func(key K, prev, next V) (V, error),
+) (map[K]V, error) {
+	m := make(map[K]V, len(s))
+
+	for _, e := range s {
+		k, v := f(e)
+		if prev, ok := m[k]; ok {
+			var err error
+			if v, err = merge(k, prev, v); err != nil {
+				return nil, err
+			}
+		}
+		m[k] = v
+	}
+
+	return m, nil
+}
+
+// KeepFirst is a merge function for AssociativeMerge that keeps the value seen first.
+func KeepFirst[K comparable, V any](_ K, prev, _ V) (V, error) {
+	return prev, nil
+}
+
+// KeepLast is a merge function for AssociativeMerge that keeps the value seen last.
+//
+// AssociativeMerge with KeepLast behaves the same as Associative.
+func KeepLast[K comparable, V any](_ K, _, next V) (V, error) {
+	return next, nil
+}
+
+// ErrorOnDuplicate is a merge function for AssociativeMerge that rejects duplicate keys.
+//
+// The returned error wraps ErrDuplicateKey.
+func ErrorOnDuplicate[K comparable, V any](key K, _, _ V) (V, error) {
+	var zero V
+	return zero, fmt.Errorf("%w: %v", ErrDuplicateKey, key)
+}
+
+// GroupBy converts a slice to a map of slices using a given function.
+//
+// The convert function f is called on each element of slice s. Values sharing the same key are
+// collected in the order they appear in s.
+func GroupBy[E any, S ~[]E, K comparable, V any](s S, f func(E) (K, V)) map[K][]V {
+	m := make(map[K][]V)
+
+	for _, e := range s {
+		k, v := f(e)
+		m[k] = append(m[k], v)
+	}
+
+	return m
+}
+
+// Invert swaps keys and values of a map.
+//
+// Should multiple keys share the same value, which one of them is kept is unspecified.
+// Use InvertGroup to keep all of them.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	inv := make(map[V]K, len(m))
+
+	for k, v := range m {
+		inv[v] = k
+	}
+
+	return inv
+}
+
+// InvertGroup swaps keys and values of a map, collecting all keys sharing the same value.
+//
+// The order of keys in each slice is unspecified.
+func InvertGroup[K, V comparable](m map[K]V) map[V][]K {
+	inv := make(map[V][]K)
+
+	for k, v := range m {
+		inv[v] = append(inv[v], k)
+	}
+
+	return inv
+}
+
 // ToVis converts a slice to a map, where each slice element is mapped to true.
 func ToVis[K comparable, S ~[]K](s S) map[K]bool {
 	m := make(map[K]bool, len(s))
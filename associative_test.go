@@ -1,8 +1,10 @@
 package util_test
 
 import (
+	"errors"
 	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -94,3 +96,113 @@ func TestToVis(t *testing.T) {
 		})
 	}
 }
+
+func TestAssociativeMerge(t *testing.T) {
+	pair := func(s string) (byte, string) { return s[0], s }
+	tests := []struct {
+		name    string
+		exec    func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name: "Keep first",
+			exec: func() (any, error) {
+				return util.AssociativeMerge([]string{"apple", "avocado", "banana"}, pair, util.KeepFirst[byte, string])
+			},
+			want: map[byte]string{'a': "apple", 'b': "banana"},
+		},
+		{
+			name: "Keep last",
+			exec: func() (any, error) {
+				return util.AssociativeMerge([]string{"apple", "avocado", "banana"}, pair, util.KeepLast[byte, string])
+			},
+			want: map[byte]string{'a': "avocado", 'b': "banana"},
+		},
+		{
+			name: "Merge callback",
+			exec: func() (any, error) {
+				return util.AssociativeMerge([]string{"apple", "avocado", "banana"}, pair,
+					func(_ byte, prev, next string) (string, error) { return prev + "," + next, nil })
+			},
+			want: map[byte]string{'a': "apple,avocado", 'b': "banana"},
+		},
+		{
+			name: "Error on duplicate",
+			exec: func() (any, error) {
+				return util.AssociativeMerge([]string{"apple", "avocado", "banana"}, pair, util.ErrorOnDuplicate[byte, string])
+			},
+			wantErr: util.ErrDuplicateKey,
+		},
+		{
+			name: "No duplicate",
+			exec: func() (any, error) {
+				return util.AssociativeMerge([]string{"apple", "banana"}, pair, util.ErrorOnDuplicate[byte, string])
+			},
+			want: map[byte]string{'a': "apple", 'b': "banana"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.exec()
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AssociativeMerge() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AssociativeMerge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	tests := []struct {
+		name string
+		exec func() any
+		want any
+	}{
+		{
+			name: "Group by parity",
+			exec: func() any {
+				return util.GroupBy([]int{1, 2, 3, 4, 5}, func(i int) (bool, int) { return i%2 == 0, i })
+			},
+			want: map[bool][]int{false: {1, 3, 5}, true: {2, 4}},
+		},
+		{
+			name: "Group by length",
+			exec: func() any {
+				return util.GroupBy([]string{"b", "ccc", "a", "dd"}, func(s string) (int, string) { return len(s), s })
+			},
+			want: map[int][]string{1: {"b", "a"}, 2: {"dd"}, 3: {"ccc"}},
+		},
+		{
+			name: "Empty slice",
+			exec: func() any {
+				return util.GroupBy([]int{}, func(i int) (int, int) { return i, i })
+			},
+			want: map[int][]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exec(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvert(t *testing.T) {
+	if got, want := util.Invert(map[string]int{"a": 1, "b": 2}), map[int]string{1: "a", 2: "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Invert() = %v, want %v", got, want)
+	}
+
+	got := util.InvertGroup(map[string]int{"a": 1, "b": 2, "c": 1})
+	for _, keys := range got {
+		slices.Sort(keys)
+	}
+	if want := map[int][]string{1: {"a", "c"}, 2: {"b"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InvertGroup() = %v, want %v", got, want)
+	}
+}
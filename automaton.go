@@ -0,0 +1,165 @@
+package util
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+// Rule computes the next value of a cell from its current value and the values of its neighbors.
+//
+// Neighbors are given in the order of the neighborhood. The slice is reused, clone it to retain.
+type Rule[T any] func(cur T, neighbors []T) T
+
+// Neighborhood converts a map of directions to deltas, such as c.Delta4 or c.Delta8, to a slice
+// ordered by direction. c.Delta9[:] can be used as a neighborhood directly.
+func Neighborhood(deltas map[c.Direction][2]int) [][2]int {
+	dirs := slices.Sorted(maps.Keys(deltas))
+	ret := make([][2]int, len(dirs))
+	for i, d := range dirs {
+		ret[i] = deltas[d]
+	}
+	return ret
+}
+
+// Automaton runs a cellular automaton on a dense rectangular grid, updating all cells simultaneously.
+type Automaton[T comparable] struct {
+	// Wrap makes the grid toroidal: neighbors beyond an edge are taken from the opposite edge.
+	Wrap bool
+	// Outside is the value of neighbors beyond the edges when Wrap is false.
+	Outside T
+	// Key identifies a state for cycle detection in Run. If nil, rows of [][]byte grids are joined,
+	// and other grids are formatted with fmt.Sprint.
+	Key func(grid [][]T) string
+	// Generation counts steps taken so far, including those skipped by Run.
+	Generation int
+
+	cur, next [][]T
+	deltas    [][2]int
+	rule      Rule[T]
+	buf       []T
+}
+
+// NewAutomaton creates an automaton starting from a copy of grid.
+//
+// deltas is the neighborhood, e.g. Neighborhood(c.Delta8) or c.Delta9[:].
+func NewAutomaton[T comparable](grid [][]T, deltas [][2]int, rule Rule[T]) *Automaton[T] {
+	return &Automaton[T]{
+		cur:    Clone(grid),
+		next:   Clone(grid),
+		deltas: deltas,
+		rule:   rule,
+		buf:    make([]T, len(deltas)),
+	}
+}
+
+// Grid returns the current state. It is overwritten by later steps, clone it to retain.
+func (a *Automaton[T]) Grid() [][]T {
+	return a.cur
+}
+
+func (a *Automaton[T]) at(i, j int) T {
+	if a.Wrap {
+		i = Mod(i, len(a.cur))
+		j = Mod(j, len(a.cur[i]))
+	} else if i < 0 || i >= len(a.cur) || j < 0 || j >= len(a.cur[i]) {
+		return a.Outside
+	}
+	return a.cur[i][j]
+}
+
+// Step advances the automaton by one generation, and reports whether any cell changed.
+func (a *Automaton[T]) Step() bool {
+	changed := false
+	for i, row := range a.cur {
+		for j, v := range row {
+			for k, d := range a.deltas {
+				a.buf[k] = a.at(i+d[0], j+d[1])
+			}
+			a.next[i][j] = a.rule(v, a.buf)
+			changed = changed || a.next[i][j] != v
+		}
+	}
+	a.cur, a.next = a.next, a.cur
+	a.Generation++
+	return changed
+}
+
+func (a *Automaton[T]) key() string {
+	if a.Key != nil {
+		return a.Key(a.cur)
+	}
+	if g, ok := any(a.cur).([][]byte); ok {
+		b := strings.Builder{}
+		for _, row := range g {
+			b.Write(row)
+			b.WriteByte('\n')
+		}
+		return b.String()
+	}
+	return fmt.Sprint(a.cur)
+}
+
+// Run advances the automaton by n generations.
+//
+// States are recorded, and once a state repeats, full cycles are skipped with SkipAhead.
+func (a *Automaton[T]) Run(n int) {
+	start := a.Generation
+	SkipAhead(a, n, func(a *Automaton[T]) *Automaton[T] { a.Step(); return a }, (*Automaton[T]).key)
+	a.Generation = start + n
+}
+
+// RunUntilStable advances the automaton until a step changes nothing, and returns the number of
+// steps taken, including the last one.
+func (a *Automaton[T]) RunUntilStable() int {
+	steps := 1
+	for a.Step() {
+		steps++
+	}
+	return steps
+}
+
+// StepSparse advances an automaton on a sparse grid by one generation, returning the new grid.
+//
+// Only cells set in g and cells having a set cell as neighbor are evaluated, all others are assumed
+// to keep the default value. Cells whose new value equals the default are not set in the result.
+func StepSparse[T comparable](g *SparseGrid[T], deltas [][2]int, rule Rule[T]) *SparseGrid[T] {
+	candidates := make(map[Point2I[int]]bool, g.Len())
+	for p := range g.All() {
+		candidates[p] = true
+		for _, d := range deltas {
+			candidates[p.AddCord([2]int{-d[0], -d[1]})] = true
+		}
+	}
+
+	ret := NewSparseGrid(g.Default())
+	buf := make([]T, len(deltas))
+	for p := range candidates {
+		for k, d := range deltas {
+			buf[k] = g.Get(p.AddCord(d))
+		}
+		if v := rule(g.Get(p), buf); v != g.Default() {
+			ret.Set(p, v)
+		}
+	}
+	return ret
+}
+
+// RunSparse advances an automaton on a sparse grid by n generations, like Automaton.Run.
+func RunSparse[T comparable](g *SparseGrid[T], deltas [][2]int, rule Rule[T], n int) *SparseGrid[T] {
+	key := func(g *SparseGrid[T]) string {
+		cells := slices.SortedFunc(maps.Keys(g.cells), func(l, r Point2I[int]) int {
+			return cmp.Or(cmp.Compare(l.X, r.X), cmp.Compare(l.Y, r.Y))
+		})
+		b := strings.Builder{}
+		for _, p := range cells {
+			fmt.Fprint(&b, p, g.cells[p], ";")
+		}
+		return b.String()
+	}
+	return SkipAhead(g, n, func(g *SparseGrid[T]) *SparseGrid[T] { return StepSparse(g, deltas, rule) }, key)
+}
@@ -0,0 +1,116 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+func life(cur byte, neighbors []byte) byte {
+	n := 0
+	for _, b := range neighbors {
+		if b == '#' {
+			n++
+		}
+	}
+	if n == 3 || (n == 2 && cur == '#') {
+		return '#'
+	}
+	return '.'
+}
+
+func toBytes(lines ...string) [][]byte {
+	ret := make([][]byte, len(lines))
+	for i, l := range lines {
+		ret[i] = []byte(l)
+	}
+	return ret
+}
+
+func TestNeighborhood(t *testing.T) {
+	want := [][2]int{c.Delta4[c.N], c.Delta4[c.E], c.Delta4[c.S], c.Delta4[c.W]}
+	if got := util.Neighborhood(c.Delta4); !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighborhood(Delta4) = %v, want %v", got, want)
+	}
+	if got := util.Neighborhood(c.Delta8); len(got) != 8 {
+		t.Errorf("Neighborhood(Delta8) has %d deltas, want 8", len(got))
+	}
+}
+
+func TestAutomaton(t *testing.T) {
+	horizontal := toBytes(".....", ".....", ".###.", ".....", ".....")
+	vertical := toBytes(".....", "..#..", "..#..", "..#..", ".....")
+
+	a := util.NewAutomaton(horizontal, util.Neighborhood(c.Delta8), life)
+	a.Outside = '.'
+	if !a.Step() || !reflect.DeepEqual(a.Grid(), vertical) {
+		t.Errorf("Step() gives %q, want %q", a.Grid(), vertical)
+	}
+	a.Run(1_000_000_001)
+	if !reflect.DeepEqual(a.Grid(), horizontal) || a.Generation != 1_000_000_002 {
+		t.Errorf("Run() gives %q at generation %d, want %q at 1000000002", a.Grid(), a.Generation, horizontal)
+	}
+	if horizontal[2][1] != '#' || horizontal[1][2] != '.' {
+		t.Errorf("NewAutomaton() modified the input grid")
+	}
+
+	// A glider on a 6x6 torus returns to its initial state after 24 generations.
+	glider := toBytes(".#....", "..#...", "###...", "......", "......", "......")
+	a = util.NewAutomaton(glider, util.Neighborhood(c.Delta8), life)
+	a.Wrap = true
+	a.Run(24)
+	if !reflect.DeepEqual(a.Grid(), glider) {
+		t.Errorf("Run(24) on torus gives %q, want %q", a.Grid(), glider)
+	}
+
+	// Each cell takes the maximum of itself and its orthogonal neighbors, until the grid is flat.
+	spread := util.NewAutomaton([][]int{{0, 0, 0}, {0, 0, 0}, {0, 0, 7}}, c.Delta9[:], func(_ int, n []int) int {
+		return max(n[1], n[3], n[4], n[5], n[7])
+	})
+	spread.Outside = -1
+	if steps := spread.RunUntilStable(); steps != 5 {
+		t.Errorf("RunUntilStable() = %d, want 5", steps)
+	}
+	if want := [][]int{{7, 7, 7}, {7, 7, 7}, {7, 7, 7}}; !reflect.DeepEqual(spread.Grid(), want) {
+		t.Errorf("RunUntilStable() gives %v, want %v", spread.Grid(), want)
+	}
+}
+
+func TestStepSparse(t *testing.T) {
+	isLive := func(b byte) bool { return b == '#' }
+	deltas := util.Neighborhood(c.Delta8)
+	glider := util.SparseGridFrom(toBytes(".#.", "..#", "###"), '.', isLive)
+
+	g := util.StepSparse(glider, deltas, life)
+	if want := toBytes("#.#", ".##", ".#."); g.Len() != 5 || !reflect.DeepEqual(g.Render(func(b byte) byte { return b }), want) {
+		t.Errorf("StepSparse() gives %q, want %q", g.Render(func(b byte) byte { return b }), want)
+	}
+
+	// A glider moves one cell diagonally every 4 generations.
+	g = util.RunSparse(glider, deltas, life, 40)
+	if lo, hi, _ := g.Bounds(); g.Len() != 5 || lo != (util.Point2I[int]{10, 10}) || hi != (util.Point2I[int]{12, 12}) {
+		t.Errorf("RunSparse(40) gives bounds %v, %v, want (10, 10), (12, 12)", lo, hi)
+	}
+
+	blinker := util.SparseGridFrom(toBytes("###"), '.', isLive)
+	if g := util.RunSparse(blinker, deltas, life, 1_000_000_001); g.Get(util.Point2I[int]{-1, 1}) != '#' || g.Len() != 3 {
+		t.Errorf("RunSparse() on blinker gives wrong phase")
+	}
+}
+
+func TestSkipAhead(t *testing.T) {
+	step := func(x int) int { return (x*x + 1) % 1000 }
+	id := func(x int) int { return x }
+	want := 3
+	for range 12345 {
+		want = step(want)
+	}
+	if got := util.SkipAhead(3, 12345, step, id); got != want {
+		t.Errorf("SkipAhead() = %d, want %d", got, want)
+	}
+	if got := util.SkipAhead(3, 0, step, id); got != 3 {
+		t.Errorf("SkipAhead(0) = %d, want 3", got)
+	}
+}
@@ -0,0 +1,137 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrMissingSeparator is wrapped by errors of lines lacking the expected separator.
+	ErrMissingSeparator = errors.New("missing separator")
+	// ErrSectionCount is returned by ParseSections when the number of blocks and sections differ.
+	ErrSectionCount = errors.New("number of blocks and sections differ")
+)
+
+var intPattern = regexp.MustCompile(`-?\d+`)
+
+// SplitBlocks splits lines into blocks separated by blank lines.
+//
+// Unlike GetBlocks, lines holding only whitespace are blank too, and empty blocks are never returned,
+// no matter how many blank lines separate blocks, or lead or trail the input.
+func SplitBlocks(lines []string) [][]string {
+	ret := make([][]string, 0)
+	blockStart := 0
+	for i := 0; i <= len(lines); i++ {
+		if i == len(lines) || len(strings.TrimSpace(lines[i])) == 0 {
+			if blockStart < i {
+				ret = append(ret, lines[blockStart:i])
+			}
+			blockStart = i + 1
+		}
+	}
+	return ret
+}
+
+// GetSections reads lines from file, and splits them into blocks with SplitBlocks.
+func GetSections(filename string) [][]string {
+	return SplitBlocks(GetLines(filename))
+}
+
+// Section parses a block of lines and stores the result. Use Into to create one.
+type Section func(block []string) error
+
+// Into creates a Section that parses a block with parse and stores the result into dst.
+//
+// parse can be one of GridBlock, IntsBlock, KeyValueBlock, or any custom function.
+func Into[T any](dst *T, parse func(block []string) (T, error)) Section {
+	return func(block []string) error {
+		v, err := parse(block)
+		if err != nil {
+			return err
+		}
+		*dst = v
+		return nil
+	}
+}
+
+// ParseSections dispatches each block to the section at the same index.
+//
+// A typical usage parses a multi-part input into a struct:
+//
+//	var input struct {
+//		Rules map[string]string
+//		Grid  [][]byte
+//	}
+//	err := util.ParseSections(util.GetSections(filename),
+//		util.Into(&input.Rules, util.KeyValueBlock(":")),
+//		util.Into(&input.Grid, util.GridBlock),
+//	)
+//
+// If the number of blocks and sections differ, an error wrapping ErrSectionCount is returned.
+// Errors from sections are wrapped with the block index.
+func ParseSections(blocks [][]string, sections ...Section) error {
+	if len(blocks) != len(sections) {
+		return fmt.Errorf("%w: %d blocks, %d sections", ErrSectionCount, len(blocks), len(sections))
+	}
+	for i, s := range sections {
+		if err := s(blocks[i]); err != nil {
+			return fmt.Errorf("block %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// GridBlock parses a block as a grid, the same as GetGrid does. It never fails.
+func GridBlock(block []string) ([][]byte, error) {
+	grid := make([][]byte, len(block))
+	for i, line := range block {
+		grid[i] = []byte(line)
+	}
+	return grid, nil
+}
+
+// ExtractInts returns all decimal integers in s, in order.
+//
+// A '-' directly before digits is taken as the sign. Values must fit in T.
+func ExtractInts[T Integer](s string) ([]T, error) {
+	return ParseAll[T](intPattern.FindAllString(s, -1), 10)
+}
+
+// IntsBlock parses a block as a list of integers, collecting all integers in all lines with ExtractInts.
+func IntsBlock[T Integer](block []string) ([]T, error) {
+	var ret []T
+	for i, line := range block {
+		nums, err := ExtractInts[T](line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		ret = append(ret, nums...)
+	}
+	return ret, nil
+}
+
+// ParseKeyValue parses lines in the form "key<sep>value" into a map.
+//
+// Lines are split at the first occurrence of sep, and surrounding spaces of keys and values are removed.
+// Should two lines have the same key, the last one is used. If a line lacks sep, a *ParseError wrapping
+// ErrMissingSeparator is returned.
+func ParseKeyValue(lines []string, sep string) (map[string]string, error) {
+	m := make(map[string]string, len(lines))
+	for i, line := range lines {
+		k, v, ok := strings.Cut(line, sep)
+		if !ok {
+			return nil, &ParseError{Index: i, Str: line, Err: fmt.Errorf("%w %q", ErrMissingSeparator, sep)}
+		}
+		m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return m, nil
+}
+
+// KeyValueBlock returns a block parser that parses lines with ParseKeyValue.
+func KeyValueBlock(sep string) func(block []string) (map[string]string, error) {
+	return func(block []string) (map[string]string, error) {
+		return ParseKeyValue(block, sep)
+	}
+}
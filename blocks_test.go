@@ -0,0 +1,89 @@
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestSplitBlocks(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want [][]string
+	}{
+		{
+			name: "Single blank lines",
+			args: []string{"a", "b", "", "c"},
+			want: [][]string{{"a", "b"}, {"c"}},
+		},
+		{
+			name: "Consecutive and whitespace-only separators",
+			args: []string{"", "a", "  ", "", "\t", "b", "c", "", ""},
+			want: [][]string{{"a"}, {"b", "c"}},
+		},
+		{
+			name: "Empty input",
+			args: []string{"", " "},
+			want: [][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.SplitBlocks(tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitBlocks() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSections(t *testing.T) {
+	lines := strings.Split("a: 1\nb : x y\n\n\n#.\n.#\n  \n1,2 -3\n4", "\n")
+
+	var input struct {
+		Rules map[string]string
+		Grid  [][]byte
+		Nums  []int
+	}
+	err := util.ParseSections(util.SplitBlocks(lines),
+		util.Into(&input.Rules, util.KeyValueBlock(":")),
+		util.Into(&input.Grid, util.GridBlock),
+		util.Into(&input.Nums, util.IntsBlock[int]),
+	)
+	if err != nil {
+		t.Fatalf("ParseSections() error = %v", err)
+	}
+	if want := map[string]string{"a": "1", "b": "x y"}; !reflect.DeepEqual(input.Rules, want) {
+		t.Errorf("ParseSections() rules = %v, want %v", input.Rules, want)
+	}
+	if want := [][]byte{[]byte("#."), []byte(".#")}; !reflect.DeepEqual(input.Grid, want) {
+		t.Errorf("ParseSections() grid = %q, want %q", input.Grid, want)
+	}
+	if want := []int{1, 2, -3, 4}; !reflect.DeepEqual(input.Nums, want) {
+		t.Errorf("ParseSections() nums = %v, want %v", input.Nums, want)
+	}
+
+	err = util.ParseSections(util.SplitBlocks(lines), util.Into(&input.Rules, util.KeyValueBlock(":")))
+	if !errors.Is(err, util.ErrSectionCount) {
+		t.Errorf("ParseSections() error = %v, want %v", err, util.ErrSectionCount)
+	}
+
+	err = util.ParseSections([][]string{{"a: 1", "b"}}, util.Into(&input.Rules, util.KeyValueBlock(":")))
+	var parseErr *util.ParseError
+	if !errors.Is(err, util.ErrMissingSeparator) || !errors.As(err, &parseErr) || parseErr.Index != 1 {
+		t.Errorf("ParseSections() error = %v, want %v at line 1", err, util.ErrMissingSeparator)
+	}
+}
+
+func TestExtractInts(t *testing.T) {
+	got, err := util.ExtractInts[int64]("Sensor at x=2, y=-18: beacon 10")
+	if want := []int64{2, -18, 10}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractInts() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := util.ExtractInts[uint8]("1 -2"); err == nil {
+		t.Errorf("ExtractInts[uint8]() error = nil, want error")
+	}
+}
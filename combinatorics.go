@@ -0,0 +1,172 @@
+package util
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Permutations returns an iterator over all permutations of s, using Heap's algorithm.
+//
+// The yielded slice is reused between iterations. Clone it to retain.
+func Permutations[S ~[]E, E any](s S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		perm := append(S(nil), s...)
+		out := make(S, len(s))
+		emit := func() bool {
+			copy(out, perm)
+			return yield(out)
+		}
+
+		if !emit() {
+			return
+		}
+		c := make([]int, len(perm))
+		for i := 1; i < len(perm); {
+			if c[i] >= i {
+				c[i] = 0
+				i++
+				continue
+			}
+			if i%2 == 0 {
+				perm[0], perm[i] = perm[i], perm[0]
+			} else {
+				perm[c[i]], perm[i] = perm[i], perm[c[i]]
+			}
+			if !emit() {
+				return
+			}
+			c[i]++
+			i = 1
+		}
+	}
+}
+
+// combinations yields index tuples of length k over n elements, each index not less than the
+// previous one plus step.
+func combinations[S ~[]E, E any](s S, k, step int) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		n := len(s)
+		if k < 0 || (step == 1 && k > n) || (n == 0 && k > 0) {
+			return
+		}
+		idx := make([]int, k)
+		for i := range idx {
+			idx[i] = i * step
+		}
+		out := make(S, k)
+		for {
+			for i, j := range idx {
+				out[i] = s[j]
+			}
+			if !yield(out) {
+				return
+			}
+
+			// Find the rightmost index that can still grow.
+			i := k - 1
+			for i >= 0 && idx[i] == n-1-(k-1-i)*step {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			idx[i]++
+			for j := i + 1; j < k; j++ {
+				idx[j] = idx[j-1] + step
+			}
+		}
+	}
+}
+
+// Combinations returns an iterator over all k-element combinations of s, in lexicographic order of indices.
+//
+// The yielded slice is reused between iterations. Clone it to retain.
+func Combinations[S ~[]E, E any](s S, k int) iter.Seq[S] {
+	return combinations(s, k, 1)
+}
+
+// CombinationsWithReplacement returns an iterator over all k-element combinations of s where elements
+// may repeat, in lexicographic order of indices.
+//
+// The yielded slice is reused between iterations. Clone it to retain.
+func CombinationsWithReplacement[S ~[]E, E any](s S, k int) iter.Seq[S] {
+	return combinations(s, k, 0)
+}
+
+// CartesianProduct returns an iterator over all tuples taking one element from each of sets, in
+// lexicographic order of indices.
+//
+// The yielded slice is reused between iterations. Clone it to retain.
+func CartesianProduct[S ~[]E, E any](sets ...S) iter.Seq[S] {
+	return func(yield func(S) bool) {
+		for _, s := range sets {
+			if len(s) == 0 {
+				return
+			}
+		}
+		idx := make([]int, len(sets))
+		out := make(S, len(sets))
+		for i, s := range sets {
+			out[i] = s[0]
+		}
+		for {
+			if !yield(out) {
+				return
+			}
+			i := len(sets) - 1
+			for ; i >= 0; i-- {
+				if idx[i]++; idx[i] < len(sets[i]) {
+					out[i] = sets[i][idx[i]]
+					break
+				}
+				idx[i] = 0
+				out[i] = sets[i][0]
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// PowerSet returns an iterator over all subsets of s.
+//
+// The i-th yielded subset contains s[j] if and only if bit j of i is set. Elements keep their order in s.
+// The yielded slice is reused between iterations. Clone it to retain.
+func PowerSet[S ~[]E, E any](s S) iter.Seq[S] {
+	Assert(len(s) < 64, "too many elements for power set")
+	return func(yield func(S) bool) {
+		out := make(S, 0, len(s))
+		for mask := uint64(0); mask < 1<<len(s); mask++ {
+			out = out[:0]
+			for j, e := range s {
+				if mask>>j&1 == 1 {
+					out = append(out, e)
+				}
+			}
+			if !yield(out) {
+				return
+			}
+		}
+	}
+}
+
+// Binomial returns the binomial coefficient C(n, k), the number of k-element subsets of an n-element set.
+//
+// It returns 0 if k < 0 or k > n. If the result does not fit in T, an error wrapping ErrOverflow is returned.
+func Binomial[T Integer](n, k T) (T, error) {
+	if k < 0 || k > n {
+		return 0, nil
+	}
+	r := T(1)
+	for i := T(0); i < min(k, n-k); i++ {
+		// r * (n-i) is divisible by i+1, divide before multiplying to delay overflow.
+		g := GCD(r, i+1)
+		a, b := r/g, (n-i)/((i+1)/g)
+		if a*b/b != a {
+			return 0, fmt.Errorf("%w: binomial(%d, %d)", ErrOverflow, n, k)
+		}
+		r = a * b
+	}
+	return r, nil
+}
@@ -0,0 +1,135 @@
+package util_test
+
+import (
+	"errors"
+	"iter"
+	"math"
+	"reflect"
+	"slices"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func collect[S ~[]E, E any](seq iter.Seq[S]) []S {
+	var ret []S
+	for s := range seq {
+		ret = append(ret, slices.Clone(s))
+	}
+	return ret
+}
+
+func TestPermutations(t *testing.T) {
+	got := collect(util.Permutations([]int{1, 2, 3}))
+	if len(got) != 6 {
+		t.Fatalf("Permutations() yields %d permutations, want 6", len(got))
+	}
+	slices.SortFunc(got, slices.Compare)
+	want := [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Permutations() = %v, want %v", got, want)
+	}
+
+	count := 0
+	for p := range util.Permutations([]int{0, 1, 2, 3, 4, 5}) {
+		p[0] = -1 // modifying the yielded slice does not affect iteration
+		count++
+	}
+	if count != 720 {
+		t.Errorf("Permutations() of 6 elements yields %d permutations, want 720", count)
+	}
+
+	if got := collect(util.Permutations([]int{})); len(got) != 1 {
+		t.Errorf("Permutations() of empty slice = %v, want one empty permutation", got)
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		name string
+		got  [][]byte
+		want [][]byte
+	}{
+		{
+			name: "Combinations",
+			got:  collect(util.Combinations([]byte("abcd"), 2)),
+			want: [][]byte{[]byte("ab"), []byte("ac"), []byte("ad"), []byte("bc"), []byte("bd"), []byte("cd")},
+		},
+		{
+			name: "Combinations all",
+			got:  collect(util.Combinations([]byte("abc"), 3)),
+			want: [][]byte{[]byte("abc")},
+		},
+		{
+			name: "Combinations too many",
+			got:  collect(util.Combinations([]byte("abc"), 4)),
+			want: nil,
+		},
+		{
+			name: "Combinations none",
+			got:  collect(util.Combinations([]byte("abc"), 0)),
+			want: [][]byte{{}},
+		},
+		{
+			name: "With replacement",
+			got:  collect(util.CombinationsWithReplacement([]byte("abc"), 2)),
+			want: [][]byte{[]byte("aa"), []byte("ab"), []byte("ac"), []byte("bb"), []byte("bc"), []byte("cc")},
+		},
+		{
+			name: "With replacement more than length",
+			got:  collect(util.CombinationsWithReplacement([]byte("ab"), 3)),
+			want: [][]byte{[]byte("aaa"), []byte("aab"), []byte("abb"), []byte("bbb")},
+		},
+		{
+			name: "Cartesian product",
+			got:  collect(util.CartesianProduct([]byte("ab"), []byte("x"), []byte("12"))),
+			want: [][]byte{[]byte("ax1"), []byte("ax2"), []byte("bx1"), []byte("bx2")},
+		},
+		{
+			name: "Cartesian product with empty set",
+			got:  collect(util.CartesianProduct([]byte("ab"), []byte{})),
+			want: nil,
+		},
+		{
+			name: "Power set",
+			got:  collect(util.PowerSet([]byte("abc"))),
+			want: [][]byte{{}, []byte("a"), []byte("b"), []byte("ab"), []byte("c"), []byte("ac"), []byte("bc"), []byte("abc")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinomial(t *testing.T) {
+	tests := []struct {
+		n, k    int64
+		want    int64
+		wantErr error
+	}{
+		{n: 5, k: 2, want: 10},
+		{n: 5, k: 0, want: 1},
+		{n: 5, k: 6, want: 0},
+		{n: 5, k: -1, want: 0},
+		{n: 62, k: 31, want: 465428353255261088},
+		{n: 66, k: 33, want: 7219428434016265740},
+		{n: 68, k: 34, wantErr: util.ErrOverflow},
+	}
+	for _, tt := range tests {
+		got, err := util.Binomial(tt.n, tt.k)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Binomial(%d, %d) = %d, %v, want %d, %v", tt.n, tt.k, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if got, err := util.Binomial[uint8](10, 3); err != nil || got != 120 {
+		t.Errorf("Binomial[uint8](10, 3) = %d, %v, want 120", got, err)
+	}
+	if _, err := util.Binomial[int32](math.MaxInt32, 2); !errors.Is(err, util.ErrOverflow) {
+		t.Errorf("Binomial[int32](MaxInt32, 2) error = %v, want %v", err, util.ErrOverflow)
+	}
+}
@@ -1,42 +1,123 @@
 package util
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
 
+// ParseError records a failed conversion of an element in a slice of strings.
+type ParseError struct {
+	// Index is the index of the offending element.
+	Index int
+	// Str is the offending element.
+	Str string
+	// Err is the underlying error, usually a *strconv.NumError.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("element %d %q: %v", e.Index, e.Str, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseNumber parses s as a number of type T, with the bit size of T.
+//
+// base is passed to strconv.ParseInt or strconv.ParseUint, and ignored for floating point types.
+func parseNumber[T Number](s string, base int) (T, error) {
+	one, zero := T(1), T(0)
+	bits := bitSize[T]()
+	switch {
+	case one/2 != 0:
+		f, err := strconv.ParseFloat(s, bits)
+		return T(f), err
+	case zero-1 < 0:
+		n, err := strconv.ParseInt(s, base, bits)
+		return T(n), err
+	default:
+		n, err := strconv.ParseUint(s, base, bits)
+		return T(n), err
+	}
+}
+
+// ParseAll converts a slice of string to a slice of T.
+//
+// Surrounding spaces of each element are ignored. For integer types, base is passed to strconv.ParseInt
+// or strconv.ParseUint, with 0 meaning the base is implied by a prefix "0b", "0o" or "0x". For floating
+// point types, base is ignored. Values must fit in T.
+//
+// If any element fails to convert, a *ParseError is returned.
+func ParseAll[T Number](strs []string, base int) ([]T, error) {
+	nums := make([]T, len(strs))
+	for i, str := range strs {
+		var err error
+		if nums[i], err = parseNumber[T](strings.TrimSpace(str), base); err != nil {
+			return nil, &ParseError{Index: i, Str: str, Err: err}
+		}
+	}
+	return nums, nil
+}
+
+// TryArrayStrToUint64 converts a slice of string to a slice of uint64.
+//
+// strconv.ParseUint is called for each element. If any error occurs, a *ParseError is returned.
+func TryArrayStrToUint64(strs []string) ([]uint64, error) {
+	return ParseAll[uint64](strs, 10)
+}
+
+// TryArrayStrToInt64 converts a slice of string to a slice of int64.
+//
+// strconv.ParseInt is called for each element. If any error occurs, a *ParseError is returned.
+func TryArrayStrToInt64(strs []string) ([]int64, error) {
+	return ParseAll[int64](strs, 10)
+}
+
+// TryArrayStrToFloat64 converts a slice of string to a slice of float64.
+//
+// strconv.ParseFloat is called for each element. If any error occurs, a *ParseError is returned.
+func TryArrayStrToFloat64(strs []string) ([]float64, error) {
+	return ParseAll[float64](strs, 10)
+}
+
 // ArrayStrToUint64 converts a slice of string to a slice of uint64.
 //
 // strconv.ParseUint is called for each element. If any error occurs, this
-// function panics with the error.
+// function panics with a *ParseError.
 func ArrayStrToUint64(strs []string) []uint64 {
-	nums := make([]uint64, len(strs))
-	for i, str := range strs {
-		nums[i] = Must(strconv.ParseUint(strings.TrimSpace(str), 10, 64))
-	}
-	return nums
+	return Must(TryArrayStrToUint64(strs))
 }
 
 // ArrayStrToInt64 converts a slice of string to a slice of int64.
 //
 // strconv.ParseInt is called for each element. If any error occurs, this
-// function panics with the error.
+// function panics with a *ParseError.
 func ArrayStrToInt64(strs []string) []int64 {
-	nums := make([]int64, len(strs))
-	for i, str := range strs {
-		nums[i] = Must(strconv.ParseInt(strings.TrimSpace(str), 10, 64))
-	}
-	return nums
+	return Must(TryArrayStrToInt64(strs))
 }
 
 // ArrayStrToFloat64 converts a slice of string to a slice of float64.
 //
 // strconv.ParseFloat is called for each element. If any error occurs, this
-// function panics with the error.
+// function panics with a *ParseError.
 func ArrayStrToFloat64(strs []string) []float64 {
-	nums := make([]float64, len(strs))
-	for i, str := range strs {
-		nums[i] = Must(strconv.ParseFloat(strings.TrimSpace(str), 64))
-	}
-	return nums
+	return Must(TryArrayStrToFloat64(strs))
+}
+
+// Atoi parses a decimal integer of type T, like strconv.Atoi.
+//
+// Surrounding spaces are ignored. strconv.ParseInt or strconv.ParseUint is used according to the
+// signedness of T, with the bit size of T.
+func Atoi[T Integer](s string) (T, error) {
+	return parseNumber[T](strings.TrimSpace(s), 10)
+}
+
+// ParseInts converts a slice of decimal strings to a slice of T.
+//
+// strconv.ParseInt or strconv.ParseUint is called for each element according to the signedness of T,
+// with the bit size of T. If any error occurs, this function panics with a *ParseError.
+func ParseInts[T Integer](strs []string) []T {
+	return Must(ParseAll[T](strs, 10))
 }
@@ -1,8 +1,11 @@
 package util_test
 
 import (
+	"errors"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	util "github.com/Xiangze-Li/golang-util"
 )
@@ -161,3 +164,138 @@ func TestArrayStrToFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestTryArrayStrToInt64(t *testing.T) {
+	got, err := util.TryArrayStrToInt64([]string{"1", " -2 ", "3"})
+	if err != nil || !reflect.DeepEqual(got, []int64{1, -2, 3}) {
+		t.Errorf("TryArrayStrToInt64() = %v, %v, want [1 -2 3]", got, err)
+	}
+
+	_, err = util.TryArrayStrToInt64([]string{"1", "2", "x3"})
+	var parseErr *util.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Index != 2 || parseErr.Str != "x3" || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("TryArrayStrToInt64() error = %v, want ParseError at index 2", err)
+	}
+
+	if _, err := util.TryArrayStrToUint64([]string{"-1"}); !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("TryArrayStrToUint64() error = %v, want %v", err, strconv.ErrSyntax)
+	}
+	if got, err := util.TryArrayStrToFloat64([]string{"1.5", "1e3"}); err != nil || !reflect.DeepEqual(got, []float64{1.5, 1000}) {
+		t.Errorf("TryArrayStrToFloat64() = %v, %v, want [1.5 1000]", got, err)
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		exec    func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name: "Hex to uint8",
+			exec: func() (any, error) { return util.ParseAll[uint8]([]string{"ff", "0A"}, 16) },
+			want: []uint8{255, 10},
+		},
+		{
+			name:    "Out of range for int8",
+			exec:    func() (any, error) { return util.ParseAll[int8]([]string{"127", "128"}, 10) },
+			wantErr: strconv.ErrRange,
+		},
+		{
+			name: "Binary to int",
+			exec: func() (any, error) { return util.ParseAll[int]([]string{"101", "-11"}, 2) },
+			want: []int{5, -3},
+		},
+		{
+			name: "Prefix implied base",
+			exec: func() (any, error) { return util.ParseAll[int32]([]string{"0x1f", "0o17", "0b11", "42"}, 0) },
+			want: []int32{31, 15, 3, 42},
+		},
+		{
+			name: "Float32",
+			exec: func() (any, error) { return util.ParseAll[float32]([]string{"0.5", "-2"}, 0) },
+			want: []float32{0.5, -2},
+		},
+		{
+			name: "Named type",
+			exec: func() (any, error) { return util.ParseAll[time.Duration]([]string{"1000"}, 10) },
+			want: []time.Duration{time.Microsecond},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.exec()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInts(t *testing.T) {
+	if got, want := util.ParseInts[int]([]string{"1", " 22", "-3"}), []int{1, 22, -3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts[int]() = %v, want %v", got, want)
+	}
+	if got, want := util.ParseInts[uint16]([]string{"65535"}), []uint16{65535}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts[uint16]() = %v, want %v", got, want)
+	}
+
+	defer func() {
+		err, ok := recover().(error)
+		var parseErr *util.ParseError
+		if !ok || !errors.As(err, &parseErr) || parseErr.Index != 1 || !errors.Is(err, strconv.ErrRange) {
+			t.Errorf("ParseInts[int8]() panicked with %v, want ParseError at index 1", err)
+		}
+	}()
+	util.ParseInts[int8]([]string{"1", "200"})
+}
+
+func TestAtoi(t *testing.T) {
+	tests := []struct {
+		name    string
+		exec    func() (any, error)
+		want    any
+		wantErr error
+	}{
+		{
+			name: "int32",
+			exec: func() (any, error) { return util.Atoi[int32](" -2147483648 ") },
+			want: int32(-2147483648),
+		},
+		{
+			name:    "int32 overflow",
+			exec:    func() (any, error) { return util.Atoi[int32]("2147483648") },
+			wantErr: strconv.ErrRange,
+		},
+		{
+			name: "uint64",
+			exec: func() (any, error) { return util.Atoi[uint64]("18446744073709551615") },
+			want: uint64(18446744073709551615),
+		},
+		{
+			name:    "uint negative",
+			exec:    func() (any, error) { return util.Atoi[uint]("-1") },
+			wantErr: strconv.ErrSyntax,
+		},
+		{
+			name:    "Not decimal",
+			exec:    func() (any, error) { return util.Atoi[int]("0x10") },
+			wantErr: strconv.ErrSyntax,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.exec()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Atoi() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("Atoi() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package util
+
+// SkipAhead returns the state after applying step n times to state.
+//
+// The key of every state is recorded. Once a key repeats, the states are known to cycle, and the
+// remaining full cycles are skipped, so that n may be huge. key must identify a state uniquely.
+// step may modify state in place and return it.
+func SkipAhead[S any, K comparable](state S, n int, step func(S) S, key func(S) K) S {
+	seen := make(map[K]int)
+	for i := 0; i < n; i++ {
+		k := key(state)
+		if j, ok := seen[k]; ok {
+			for rest := (n - i) % (i - j); rest > 0; rest-- {
+				state = step(state)
+			}
+			return state
+		}
+		seen[k] = i
+		state = step(state)
+	}
+	return state
+}
@@ -0,0 +1,109 @@
+package util
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// DifferenceTable returns the finite-difference table of seq.
+//
+// The first row is seq itself, each next row holds the differences between adjacent elements of the
+// previous one. The table ends with the first row whose elements are all zero, or with a row of
+// length 1 if that never happens.
+func DifferenceTable[T SignedInteger](seq []T) [][]T {
+	table := [][]T{slices.Clone(seq)}
+	for {
+		last := table[len(table)-1]
+		if len(last) <= 1 || !slices.ContainsFunc(last, func(v T) bool { return v != 0 }) {
+			return table
+		}
+		next := make([]T, len(last)-1)
+		for i := range next {
+			next[i] = last[i+1] - last[i]
+		}
+		table = append(table, next)
+	}
+}
+
+// ExtrapolateNext predicts the element following seq, using its finite-difference table.
+//
+// If seq is empty, this function panics.
+func ExtrapolateNext[T SignedInteger](seq []T) T {
+	Assert(len(seq) > 0, "empty sequence")
+	var next T
+	for _, row := range DifferenceTable(seq) {
+		next += row[len(row)-1]
+	}
+	return next
+}
+
+// ExtrapolatePrev predicts the element preceding seq, using its finite-difference table.
+//
+// If seq is empty, this function panics.
+func ExtrapolatePrev[T SignedInteger](seq []T) T {
+	Assert(len(seq) > 0, "empty sequence")
+	table := DifferenceTable(seq)
+	var prev T
+	for i := len(table) - 1; i >= 0; i-- {
+		prev = table[i][0] - prev
+	}
+	return prev
+}
+
+// Interpolate evaluates at x the unique polynomial of degree less than len(xs) passing through
+// points (xs[i], ys[i]), using Lagrange interpolation.
+//
+// The result is exact. Elements of xs must be distinct. Arithmetic panics with an error wrapping
+// ErrOverflow if an intermediate value does not fit in T.
+func Interpolate[T SignedInteger](xs, ys []T, x T) Rational[T] {
+	Assert(len(xs) == len(ys), "xs and ys have different lengths")
+	var sum Rational[T]
+	for i := range xs {
+		term := RationalFromInt(ys[i])
+		for j := range xs {
+			if i != j {
+				term = term.Mul(NewRational(addChecked(x, negChecked(xs[j])), addChecked(xs[i], negChecked(xs[j]))))
+			}
+		}
+		sum = sum.Add(term)
+	}
+	return sum
+}
+
+// InterpolateSeq is like Interpolate, where seq[i] is the value at index i.
+//
+// It predicts the element of seq at index n, which may be far beyond its end. The value is computed by
+// Newton's forward-difference formula, summing the leading element of each row of the difference table
+// times binomial(n, k), so only the true degree of the sequence matters. Intermediate values are exact,
+// and it panics with an error wrapping ErrOverflow only if the result does not fit in T.
+func InterpolateSeq[T SignedInteger](seq []T, n T) Rational[T] {
+	row := make([]*big.Int, len(seq))
+	for i, v := range seq {
+		row[i] = big.NewInt(int64(v))
+	}
+	bn := big.NewInt(int64(n))
+
+	sum, binom := new(big.Int), big.NewInt(1)
+	var t big.Int
+	for k := 0; len(row) > 0; k++ {
+		if !slices.ContainsFunc(row, func(v *big.Int) bool { return v.Sign() != 0 }) {
+			break
+		}
+		if k > 0 {
+			// binomial(n, k) = binomial(n, k-1) * (n-k+1) / k, where the division is exact.
+			binom.Mul(binom, t.Sub(bn, big.NewInt(int64(k-1))))
+			binom.Quo(binom, big.NewInt(int64(k)))
+		}
+		sum.Add(sum, t.Mul(row[0], binom))
+		for i := range len(row) - 1 {
+			row[i].Sub(row[i+1], row[i])
+		}
+		row = row[:len(row)-1]
+	}
+
+	if !sum.IsInt64() || int64(T(sum.Int64())) != sum.Int64() {
+		panic(fmt.Errorf("%w: InterpolateSeq(%v, %d) = %v", ErrOverflow, seq, n, sum))
+	}
+	return RationalFromInt(T(sum.Int64()))
+}
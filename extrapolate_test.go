@@ -0,0 +1,73 @@
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestDifferenceTable(t *testing.T) {
+	got := util.DifferenceTable([]int{1, 3, 6, 10, 15, 21})
+	want := [][]int{{1, 3, 6, 10, 15, 21}, {2, 3, 4, 5, 6}, {1, 1, 1, 1}, {0, 0, 0}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DifferenceTable() = %v, want %v", got, want)
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+	tests := []struct {
+		seq        []int64
+		next, prev int64
+	}{
+		{seq: []int64{0, 3, 6, 9, 12, 15}, next: 18, prev: -3},
+		{seq: []int64{1, 3, 6, 10, 15, 21}, next: 28, prev: 0},
+		{seq: []int64{10, 13, 16, 21, 30, 45}, next: 68, prev: 5},
+		{seq: []int64{7}, next: 7, prev: 7},
+		{seq: []int64{1, 2, 4, 8}, next: 15, prev: 0},
+	}
+	for _, tt := range tests {
+		if got := util.ExtrapolateNext(tt.seq); got != tt.next {
+			t.Errorf("ExtrapolateNext(%v) = %d, want %d", tt.seq, got, tt.next)
+		}
+		if got := util.ExtrapolatePrev(tt.seq); got != tt.prev {
+			t.Errorf("ExtrapolatePrev(%v) = %d, want %d", tt.seq, got, tt.prev)
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	square := func(x int64) int64 { return 3*x*x - 2*x + 7 }
+	xs := []int64{65, 196, 327}
+	ys := []int64{square(65), square(196), square(327)}
+	const far = 26501365
+	if got := util.Interpolate(xs, ys, far); got != util.RationalFromInt(square(far)) {
+		t.Errorf("Interpolate() = %v, want %d", got, square(far))
+	}
+
+	if got := util.Interpolate([]int64{0, 2}, []int64{0, 1}, 1); got != util.NewRational[int64](1, 2) {
+		t.Errorf("Interpolate() = %v, want 1/2", got)
+	}
+
+	if got := util.InterpolateSeq([]int64{1, 3, 6, 10}, 99); got != util.RationalFromInt[int64](5050) {
+		t.Errorf("InterpolateSeq() = %v, want 5050", got)
+	}
+	// Lagrange terms grow like far^3, though the sequence is quadratic.
+	if got, want := util.InterpolateSeq([]int64{2, 3, 6, 11}, far), int64(far)*far+2; got != util.RationalFromInt[int64](want) {
+		t.Errorf("InterpolateSeq() = %v, want %d", got, want)
+	}
+	if got := util.InterpolateSeq([]int64{1, 3, 6, 10}, -3); got != util.RationalFromInt[int64](1) {
+		t.Errorf("InterpolateSeq() = %v, want 1", got)
+	}
+
+	// 100 - (-100) does not fit in int8.
+	err := func() (err error) {
+		defer util.Recover(&err)
+		util.Interpolate([]int8{-100, 100}, []int8{0, 2}, 20)
+		return nil
+	}()
+	if !errors.Is(err, util.ErrOverflow) {
+		t.Errorf("Interpolate() error = %v, want ErrOverflow", err)
+	}
+}
@@ -0,0 +1,165 @@
+package util
+
+import (
+	"cmp"
+)
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Map converts each element of slice s using a given function.
+func Map[S ~[]E, E any, R any](s S, f func(E) R) []R {
+	ret := make([]R, len(s))
+	for i, e := range s {
+		ret[i] = f(e)
+	}
+	return ret
+}
+
+// MapIndex converts each element of slice s using a given function.
+//
+// The 1st argument to the convert function f is the index of the current element.
+func MapIndex[S ~[]E, E any, R any](s S, f func(int, E) R) []R {
+	ret := make([]R, len(s))
+	for i, e := range s {
+		ret[i] = f(i, e)
+	}
+	return ret
+}
+
+// Filter returns the elements of slice s for which f returns true, keeping their order.
+func Filter[S ~[]E, E any](s S, f func(E) bool) S {
+	var ret S
+	for _, e := range s {
+		if f(e) {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// FlatMap converts each element of slice s to a slice using a given function, and concatenates the results.
+func FlatMap[S ~[]E, E any, R any](s S, f func(E) []R) []R {
+	var ret []R
+	for _, e := range s {
+		ret = append(ret, f(e)...)
+	}
+	return ret
+}
+
+// Zip pairs up elements of a and b with the same index. Extra elements of the longer slice are dropped.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	ret := make([]Pair[A, B], min(len(a), len(b)))
+	for i := range ret {
+		ret[i] = Pair[A, B]{a[i], b[i]}
+	}
+	return ret
+}
+
+// Unzip splits a slice of pairs into two slices.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+	return a, b
+}
+
+// SlidingWindow returns all windows of n consecutive elements of slice s.
+//
+// Windows are sub-slices sharing memory with s. If n is not positive, this function panics.
+func SlidingWindow[S ~[]E, E any](s S, n int) []S {
+	Assert(n > 0, "window size must be positive")
+	if len(s) < n {
+		return nil
+	}
+	ret := make([]S, len(s)-n+1)
+	for i := range ret {
+		ret[i] = s[i : i+n : i+n]
+	}
+	return ret
+}
+
+// Chunk splits slice s into consecutive chunks of n elements. The last chunk may be shorter.
+//
+// Chunks are sub-slices sharing memory with s. If n is not positive, this function panics.
+func Chunk[S ~[]E, E any](s S, n int) []S {
+	Assert(n > 0, "chunk size must be positive")
+	ret := make([]S, 0, (len(s)+n-1)/n)
+	for i := 0; i < len(s); i += n {
+		end := min(i+n, len(s))
+		ret = append(ret, s[i:end:end])
+	}
+	return ret
+}
+
+// Partition splits slice s into elements for which f returns true and those for which it returns false,
+// keeping their order.
+func Partition[S ~[]E, E any](s S, f func(E) bool) (S, S) {
+	var yes, no S
+	for _, e := range s {
+		if f(e) {
+			yes = append(yes, e)
+		} else {
+			no = append(no, e)
+		}
+	}
+	return yes, no
+}
+
+// Scan is like Reduce, but returns all intermediate values.
+//
+// The i-th element of the result is the reduced value after s[i] is consumed.
+func Scan[S ~[]E, E any, R any](s S, f func(R, E) R, init R) []R {
+	ret := make([]R, len(s))
+	for i, e := range s {
+		init = f(init, e)
+		ret[i] = init
+	}
+	return ret
+}
+
+// Sum returns the sum of all elements of slice s, or 0 if s is empty.
+func Sum[S ~[]T, T Integer](s S) T {
+	var sum T
+	for _, e := range s {
+		sum += e
+	}
+	return sum
+}
+
+// Product returns the product of all elements of slice s, or 1 if s is empty.
+func Product[S ~[]T, T Integer](s S) T {
+	prod := T(1)
+	for _, e := range s {
+		prod *= e
+	}
+	return prod
+}
+
+// MinBy returns the first element of slice s with the minimal key. If s is empty, this function panics.
+func MinBy[S ~[]E, E any, K cmp.Ordered](s S, key func(E) K) E {
+	Assert(len(s) > 0, "MinBy: empty slice")
+	best, bestKey := s[0], key(s[0])
+	for _, e := range s[1:] {
+		if k := key(e); k < bestKey {
+			best, bestKey = e, k
+		}
+	}
+	return best
+}
+
+// MaxBy returns the first element of slice s with the maximal key. If s is empty, this function panics.
+func MaxBy[S ~[]E, E any, K cmp.Ordered](s S, key func(E) K) E {
+	Assert(len(s) > 0, "MaxBy: empty slice")
+	best, bestKey := s[0], key(s[0])
+	for _, e := range s[1:] {
+		if k := key(e); k > bestKey {
+			best, bestKey = e, k
+		}
+	}
+	return best
+}
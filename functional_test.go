@@ -0,0 +1,115 @@
+package util_test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestFunctional(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	tests := []struct {
+		name string
+		exec func() any
+		want any
+	}{
+		{
+			name: "Map",
+			exec: func() any { return util.Map([]int{1, 2, 3}, strconv.Itoa) },
+			want: []string{"1", "2", "3"},
+		},
+		{
+			name: "MapIndex",
+			exec: func() any {
+				return util.MapIndex([]string{"a", "b"}, func(i int, s string) string { return strings.Repeat(s, i+1) })
+			},
+			want: []string{"a", "bb"},
+		},
+		{
+			name: "Filter",
+			exec: func() any { return util.Filter([]int{1, 2, 3, 4}, isEven) },
+			want: []int{2, 4},
+		},
+		{
+			name: "Filter none",
+			exec: func() any { return util.Filter([]int{1, 3}, isEven) },
+			want: []int(nil),
+		},
+		{
+			name: "FlatMap",
+			exec: func() any { return util.FlatMap([]string{"a b", "c"}, strings.Fields) },
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "Zip",
+			exec: func() any { return util.Zip([]int{1, 2, 3}, []string{"a", "b"}) },
+			want: []util.Pair[int, string]{{1, "a"}, {2, "b"}},
+		},
+		{
+			name: "Unzip",
+			exec: func() any {
+				a, b := util.Unzip([]util.Pair[int, string]{{1, "a"}, {2, "b"}})
+				return []any{a, b}
+			},
+			want: []any{[]int{1, 2}, []string{"a", "b"}},
+		},
+		{
+			name: "SlidingWindow",
+			exec: func() any { return util.SlidingWindow([]int{1, 2, 3, 4}, 3) },
+			want: [][]int{{1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			name: "SlidingWindow too short",
+			exec: func() any { return util.SlidingWindow([]int{1, 2}, 3) },
+			want: [][]int(nil),
+		},
+		{
+			name: "Chunk",
+			exec: func() any { return util.Chunk([]int{1, 2, 3, 4, 5}, 2) },
+			want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name: "Partition",
+			exec: func() any {
+				yes, no := util.Partition([]int{1, 2, 3, 4, 5}, isEven)
+				return [][]int{yes, no}
+			},
+			want: [][]int{{2, 4}, {1, 3, 5}},
+		},
+		{
+			name: "Scan",
+			exec: func() any { return util.Scan([]int{1, 2, 3, 4}, func(acc, i int) int { return acc + i }, 10) },
+			want: []int{11, 13, 16, 20},
+		},
+		{
+			name: "Sum",
+			exec: func() any { return util.Sum([]int64{1, 2, 3, 4}) },
+			want: int64(10),
+		},
+		{
+			name: "Product",
+			exec: func() any { return util.Product([]uint8{}) },
+			want: uint8(1),
+		},
+		{
+			name: "MinBy",
+			exec: func() any { return util.MinBy([]string{"ccc", "a", "bb", "d"}, func(s string) int { return len(s) }) },
+			want: "a",
+		},
+		{
+			name: "MaxBy",
+			exec: func() any { return util.MaxBy([]string{"ccc", "a", "bb", "ddd"}, func(s string) int { return len(s) }) },
+			want: "ccc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.exec(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Xiangze-Li/golang-util/unionfind"
+)
+
+// ErrUndirected is returned by algorithms that only apply to directed graphs.
+var ErrUndirected = errors.New("graph is undirected")
+
+// CycleError is returned by TopoSort when the graph contains a cycle.
+type CycleError[N comparable] struct {
+	// Cycle lists nodes along the cycle, each having an edge to the next one and the last one to the first one.
+	Cycle []N
+}
+
+func (e *CycleError[N]) Error() string {
+	b := strings.Builder{}
+	b.WriteString("cycle detected: ")
+	for _, n := range e.Cycle {
+		fmt.Fprintf(&b, "%v -> ", n)
+	}
+	fmt.Fprint(&b, e.Cycle[0])
+	return b.String()
+}
+
+// TopoSort returns the nodes of a directed graph in topological order.
+//
+// The order is the lexicographically smallest one by the order nodes were added: whenever several
+// nodes may come next, the one added earliest is chosen. If the graph
+// contains a cycle, a *CycleError is returned. If the graph is undirected, ErrUndirected is returned.
+func (g *Graph[N]) TopoSort() ([]N, error) {
+	if !g.directed {
+		return nil, ErrUndirected
+	}
+
+	indeg := make([]int, len(g.nodes))
+	for _, arcs := range g.adj {
+		for _, a := range arcs {
+			indeg[a.to]++
+		}
+	}
+
+	ready := minHeap{}
+	for u, d := range indeg {
+		if d == 0 {
+			ready = append(ready, u)
+		}
+	}
+	order := make([]int, 0, len(g.nodes))
+	for ready.Len() > 0 {
+		u := heap.Pop(&ready).(int)
+		order = append(order, u)
+		for _, a := range g.adj[u] {
+			indeg[a.to]--
+			if indeg[a.to] == 0 {
+				heap.Push(&ready, a.to)
+			}
+		}
+	}
+
+	if len(order) < len(g.nodes) {
+		return nil, &CycleError[N]{Cycle: g.findCycle(indeg)}
+	}
+
+	ret := make([]N, len(order))
+	for i, u := range order {
+		ret[i] = g.nodes[u]
+	}
+	return ret, nil
+}
+
+type minHeap []int
+
+func (h minHeap) Len() int           { return len(h) }
+func (h minHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h minHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *minHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *minHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// findCycle finds a cycle among nodes with positive remaining in-degree after Kahn's algorithm.
+func (g *Graph[N]) findCycle(indeg []int) []N {
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make([]int, len(g.nodes))
+	var stack []int
+	var cycle []N
+
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		color[u] = grey
+		stack = append(stack, u)
+		for _, a := range g.adj[u] {
+			if indeg[a.to] == 0 {
+				continue
+			}
+			switch color[a.to] {
+			case grey:
+				for i := len(stack) - 1; ; i-- {
+					if stack[i] == a.to {
+						for _, v := range stack[i:] {
+							cycle = append(cycle, g.nodes[v])
+						}
+						return true
+					}
+				}
+			case white:
+				if dfs(a.to) {
+					return true
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		color[u] = black
+		return false
+	}
+
+	for u := range g.nodes {
+		if indeg[u] > 0 && color[u] == white && dfs(u) {
+			break
+		}
+	}
+	return cycle
+}
+
+// SCC returns the strongly connected components of a directed graph, using Tarjan's algorithm.
+//
+// Components are returned in reverse topological order: no edge goes from a component to a later one.
+// For an undirected graph, the components are the connected components.
+func (g *Graph[N]) SCC() [][]N {
+	index := make([]int, len(g.nodes))
+	low := make([]int, len(g.nodes))
+	onStack := make([]bool, len(g.nodes))
+	var stack []int
+	var comps [][]N
+	next := 1
+
+	var strongConnect func(u int)
+	strongConnect = func(u int) {
+		index[u], low[u] = next, next
+		next++
+		stack = append(stack, u)
+		onStack[u] = true
+
+		for _, a := range g.adj[u] {
+			if index[a.to] == 0 {
+				strongConnect(a.to)
+				low[u] = min(low[u], low[a.to])
+			} else if onStack[a.to] {
+				low[u] = min(low[u], index[a.to])
+			}
+		}
+
+		if low[u] == index[u] {
+			var comp []N
+			for {
+				v := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[v] = false
+				comp = append(comp, g.nodes[v])
+				if v == u {
+					break
+				}
+			}
+			comps = append(comps, comp)
+		}
+	}
+
+	for u := range g.nodes {
+		if index[u] == 0 {
+			strongConnect(u)
+		}
+	}
+	return comps
+}
+
+// Components returns the connected components of the graph.
+//
+// For a directed graph, edge directions are ignored, giving the weakly connected components.
+// Components and nodes in each component are ordered by the time they were added.
+func (g *Graph[N]) Components() [][]N {
+	set := unionfind.NewIndexed(len(g.nodes))
+	for u, arcs := range g.adj {
+		for _, a := range arcs {
+			set.Union(u, a.to)
+		}
+	}
+
+	ids := set.Components()
+	comps := make([][]N, len(ids))
+	for i, c := range ids {
+		comps[i] = make([]N, len(c))
+		for j, u := range c {
+			comps[i][j] = g.nodes[u]
+		}
+	}
+	return comps
+}
+
+func (g *Graph[N]) reach(from int) []bool {
+	vis := make([]bool, len(g.nodes))
+	vis[from] = true
+	queue := []int{from}
+	for i := 0; i < len(queue); i++ {
+		for _, a := range g.adj[queue[i]] {
+			if !vis[a.to] {
+				vis[a.to] = true
+				queue = append(queue, a.to)
+			}
+		}
+	}
+	return vis
+}
+
+// Reachable returns all nodes reachable from node from, including itself, in the order they were added.
+//
+// It returns nil if from is not a node of g.
+func (g *Graph[N]) Reachable(from N) []N {
+	u, ok := g.index[from]
+	if !ok {
+		return nil
+	}
+	var ret []N
+	for v, ok := range g.reach(u) {
+		if ok {
+			ret = append(ret, g.nodes[v])
+		}
+	}
+	return ret
+}
+
+// Reachability computes the reachability between all pairs of nodes.
+//
+// ret[a][b] is true if b is reachable from a. Every node is reachable from itself.
+func (g *Graph[N]) Reachability() map[N]map[N]bool {
+	ret := make(map[N]map[N]bool, len(g.nodes))
+	for u, n := range g.nodes {
+		m := make(map[N]bool)
+		for v, ok := range g.reach(u) {
+			if ok {
+				m[g.nodes[v]] = true
+			}
+		}
+		ret[n] = m
+	}
+	return ret
+}
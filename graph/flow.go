@@ -0,0 +1,387 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"slices"
+)
+
+// ErrDirected is returned by algorithms that only apply to undirected graphs.
+var ErrDirected = errors.New("graph is directed")
+
+// Cut is a partition of the nodes of a graph into two sides.
+type Cut[N comparable] struct {
+	// Weight is the total weight of the edges crossing the cut.
+	Weight int64
+	// Edges are the edges crossing the cut.
+	//
+	// In a directed graph, only edges from Source to Sink are included.
+	Edges []Edge[N]
+	// Source and Sink are the two sides of the cut, in the order nodes were added.
+	Source, Sink []N
+}
+
+// network is a residual network. Edge i and edge i^1 are reverse to each other.
+type network struct {
+	head [][]int
+	to   []int
+	cap  []int64
+}
+
+// network builds a residual network, using edge weights as capacities.
+func (g *Graph[N]) network() *network {
+	nw := &network{head: make([][]int, len(g.nodes))}
+	add := func(u, v int, c, rc int64) {
+		nw.head[u] = append(nw.head[u], len(nw.to))
+		nw.to, nw.cap = append(nw.to, v), append(nw.cap, c)
+		nw.head[v] = append(nw.head[v], len(nw.to))
+		nw.to, nw.cap = append(nw.to, u), append(nw.cap, rc)
+	}
+	g.eachEdge(func(u, v int, w int64) {
+		if g.directed {
+			add(u, v, w, 0)
+		} else {
+			add(u, v, w, w)
+		}
+	})
+	return nw
+}
+
+// levels computes BFS distances from s over edges with remaining capacity. Unreachable nodes get -1.
+func (nw *network) levels(s int) []int {
+	level := make([]int, len(nw.head))
+	for i := range level {
+		level[i] = -1
+	}
+	level[s] = 0
+	queue := []int{s}
+	for i := 0; i < len(queue); i++ {
+		u := queue[i]
+		for _, e := range nw.head[u] {
+			if v := nw.to[e]; nw.cap[e] > 0 && level[v] < 0 {
+				level[v] = level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+	return level
+}
+
+func (nw *network) dinic(s, t int) int64 {
+	var flow int64
+	for {
+		level := nw.levels(s)
+		if level[t] < 0 {
+			return flow
+		}
+		iter := make([]int, len(nw.head))
+
+		var push func(u int, limit int64) int64
+		push = func(u int, limit int64) int64 {
+			if u == t {
+				return limit
+			}
+			for ; iter[u] < len(nw.head[u]); iter[u]++ {
+				e := nw.head[u][iter[u]]
+				v := nw.to[e]
+				if nw.cap[e] <= 0 || level[v] != level[u]+1 {
+					continue
+				}
+				if d := push(v, min(limit, nw.cap[e])); d > 0 {
+					nw.cap[e] -= d
+					nw.cap[e^1] += d
+					return d
+				}
+			}
+			return 0
+		}
+
+		for d := push(s, math.MaxInt64); d > 0; d = push(s, math.MaxInt64) {
+			flow += d
+		}
+	}
+}
+
+func (nw *network) edmondsKarp(s, t int) int64 {
+	var flow int64
+	for {
+		via := make([]int, len(nw.head))
+		for i := range via {
+			via[i] = -1
+		}
+		queue := []int{s}
+		for i := 0; i < len(queue) && via[t] < 0; i++ {
+			u := queue[i]
+			for _, e := range nw.head[u] {
+				if v := nw.to[e]; nw.cap[e] > 0 && v != s && via[v] < 0 {
+					via[v] = e
+					queue = append(queue, v)
+				}
+			}
+		}
+		if via[t] < 0 {
+			return flow
+		}
+
+		d := int64(math.MaxInt64)
+		for v := t; v != s; v = nw.to[via[v]^1] {
+			d = min(d, nw.cap[via[v]])
+		}
+		for v := t; v != s; v = nw.to[via[v]^1] {
+			nw.cap[via[v]] -= d
+			nw.cap[via[v]^1] += d
+		}
+		flow += d
+	}
+}
+
+// st returns the indices of s and t, and whether a flow between them is meaningful.
+func (g *Graph[N]) st(s, t N) (int, int, bool) {
+	u, okS := g.index[s]
+	v, okT := g.index[t]
+	return u, v, okS && okT && u != v
+}
+
+// MaxFlow returns the maximum flow from node s to node t, using Dinic's algorithm.
+//
+// Edge weights are used as capacities. In an undirected graph, each edge can carry flow either way.
+// The flow is 0 if s equals t, or either of them is not a node of g.
+func (g *Graph[N]) MaxFlow(s, t N) int64 {
+	u, v, ok := g.st(s, t)
+	if !ok {
+		return 0
+	}
+	return g.network().dinic(u, v)
+}
+
+// MaxFlowEdmondsKarp returns the same result as MaxFlow, using the Edmonds-Karp algorithm.
+func (g *Graph[N]) MaxFlowEdmondsKarp(s, t N) int64 {
+	u, v, ok := g.st(s, t)
+	if !ok {
+		return 0
+	}
+	return g.network().edmondsKarp(u, v)
+}
+
+// MinCut returns a minimum cut separating node s from node t.
+//
+// Edge weights are used as capacities. The weight of the cut equals the maximum flow from s to t.
+// The source side contains exactly the nodes reachable from s in the residual network.
+// If s equals t, or either of them is not a node of g, the zero Cut is returned, like MaxFlow returns 0.
+func (g *Graph[N]) MinCut(s, t N) Cut[N] {
+	u, v, ok := g.st(s, t)
+	if !ok {
+		return Cut[N]{}
+	}
+
+	inSource := make([]bool, len(g.nodes))
+	nw := g.network()
+	nw.dinic(u, v)
+	for x, l := range nw.levels(u) {
+		inSource[x] = l >= 0
+	}
+	return g.cut(inSource)
+}
+
+// cut builds a Cut from a partition of nodes.
+func (g *Graph[N]) cut(inSource []bool) Cut[N] {
+	var c Cut[N]
+	for u, n := range g.nodes {
+		if inSource[u] {
+			c.Source = append(c.Source, n)
+		} else {
+			c.Sink = append(c.Sink, n)
+		}
+	}
+	g.eachEdge(func(u, v int, w int64) {
+		if inSource[u] && !inSource[v] {
+			c.Edges = append(c.Edges, Edge[N]{g.nodes[u], g.nodes[v], w})
+			c.Weight += w
+		} else if !g.directed && !inSource[u] && inSource[v] {
+			c.Edges = append(c.Edges, Edge[N]{g.nodes[v], g.nodes[u], w})
+			c.Weight += w
+		}
+	})
+	return c
+}
+
+type weighted struct {
+	node int
+	key  int64
+}
+
+type maxHeap []weighted
+
+func (h maxHeap) Len() int           { return len(h) }
+func (h maxHeap) Less(i, j int) bool { return h[i].key > h[j].key }
+func (h maxHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *maxHeap) Push(x any)        { *h = append(*h, x.(weighted)) }
+func (h *maxHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// GlobalMinCut returns a minimum cut of an undirected graph, using the Stoer-Wagner algorithm.
+//
+// Edge weights must be non-negative. Both sides of the cut are non-empty if the graph has at least two nodes.
+// If the graph is directed, ErrDirected is returned.
+func (g *Graph[N]) GlobalMinCut() (Cut[N], error) {
+	if g.directed {
+		return Cut[N]{}, ErrDirected
+	}
+	n := len(g.nodes)
+	inSource := make([]bool, n)
+	if n < 2 {
+		return g.cut(inSource), nil
+	}
+
+	adj := make([]map[int]int64, n)
+	groups := make([][]int, n)
+	active := make([]int, n)
+	for u := range adj {
+		adj[u] = make(map[int]int64)
+		groups[u] = []int{u}
+		active[u] = u
+	}
+	g.eachEdge(func(u, v int, w int64) {
+		if u != v {
+			adj[u][v] += w
+			adj[v][u] += w
+		}
+	})
+
+	best := int64(math.MaxInt64)
+	var bestGroup []int
+	key := make([]int64, n)
+	added := make([]bool, n)
+	for len(active) > 1 {
+		// Maximum adjacency ordering of active nodes.
+		h := make(maxHeap, 0, len(active))
+		for _, u := range active {
+			key[u], added[u] = 0, false
+			h = append(h, weighted{u, 0})
+		}
+		prev, last := -1, -1
+		for range active {
+			var u int
+			for {
+				x := heap.Pop(&h).(weighted)
+				if u = x.node; !added[u] && key[u] == x.key {
+					break
+				}
+			}
+			added[u] = true
+			prev, last = last, u
+			for v, w := range adj[u] {
+				if !added[v] {
+					key[v] += w
+					heap.Push(&h, weighted{v, key[v]})
+				}
+			}
+		}
+
+		if key[last] < best {
+			best = key[last]
+			bestGroup = slices.Clone(groups[last])
+		}
+
+		// Merge last into prev.
+		groups[prev] = append(groups[prev], groups[last]...)
+		for v, w := range adj[last] {
+			delete(adj[v], last)
+			if v != prev {
+				adj[prev][v] += w
+				adj[v][prev] += w
+			}
+		}
+		adj[last] = nil
+		active = slices.DeleteFunc(active, func(u int) bool { return u == last })
+	}
+
+	for _, u := range bestGroup {
+		inSource[u] = true
+	}
+	return g.cut(inSource), nil
+}
+
+// MaxMatching returns a maximum matching of a bipartite graph, using the Hopcroft-Karp algorithm.
+//
+// Nodes in left form one side of the graph. Edges from a left node to any node not in left are
+// considered, all other edges are ignored. The result maps each matched left node to its partner.
+func (g *Graph[N]) MaxMatching(left []N) map[N]N {
+	isLeft := make([]bool, len(g.nodes))
+	var ls []int
+	for _, n := range left {
+		if u, ok := g.index[n]; ok && !isLeft[u] {
+			isLeft[u] = true
+			ls = append(ls, u)
+		}
+	}
+
+	const inf = math.MaxInt
+	matchL := make(map[int]int, len(ls))
+	matchR := make(map[int]int)
+	dist := make(map[int]int, len(ls))
+
+	bfs := func() bool {
+		var queue []int
+		for _, u := range ls {
+			if _, ok := matchL[u]; ok {
+				dist[u] = inf
+			} else {
+				dist[u] = 0
+				queue = append(queue, u)
+			}
+		}
+		found := false
+		for i := 0; i < len(queue); i++ {
+			u := queue[i]
+			for _, a := range g.adj[u] {
+				if isLeft[a.to] {
+					continue
+				}
+				w, ok := matchR[a.to]
+				if !ok {
+					found = true
+				} else if dist[w] == inf {
+					dist[w] = dist[u] + 1
+					queue = append(queue, w)
+				}
+			}
+		}
+		return found
+	}
+
+	var dfs func(u int) bool
+	dfs = func(u int) bool {
+		for _, a := range g.adj[u] {
+			if isLeft[a.to] {
+				continue
+			}
+			w, ok := matchR[a.to]
+			if !ok || (dist[w] == dist[u]+1 && dfs(w)) {
+				matchL[u], matchR[a.to] = a.to, u
+				return true
+			}
+		}
+		dist[u] = inf
+		return false
+	}
+
+	for bfs() {
+		for _, u := range ls {
+			if _, ok := matchL[u]; !ok {
+				dfs(u)
+			}
+		}
+	}
+
+	ret := make(map[N]N, len(matchL))
+	for u, v := range matchL {
+		ret[g.nodes[u]] = g.nodes[v]
+	}
+	return ret
+}
@@ -0,0 +1,118 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Xiangze-Li/golang-util/graph"
+)
+
+func clrsNetwork() *graph.Graph[string] {
+	g := graph.NewDirected[string]()
+	for _, e := range []graph.Edge[string]{
+		{"s", "v1", 16}, {"s", "v2", 13}, {"v1", "v3", 12}, {"v2", "v1", 4}, {"v2", "v4", 14},
+		{"v3", "v2", 9}, {"v3", "t", 20}, {"v4", "v3", 7}, {"v4", "t", 4},
+	} {
+		g.AddWeightedEdge(e.From, e.To, e.Weight)
+	}
+	return g
+}
+
+func TestMaxFlow(t *testing.T) {
+	g := clrsNetwork()
+	if got := g.MaxFlow("s", "t"); got != 23 {
+		t.Errorf("MaxFlow() = %d, want 23", got)
+	}
+	if got := g.MaxFlowEdmondsKarp("s", "t"); got != 23 {
+		t.Errorf("MaxFlowEdmondsKarp() = %d, want 23", got)
+	}
+	if got := g.MaxFlow("t", "s"); got != 0 {
+		t.Errorf("MaxFlow(t, s) = %d, want 0", got)
+	}
+	if got := g.MaxFlow("s", "s"); got != 0 {
+		t.Errorf("MaxFlow(s, s) = %d, want 0", got)
+	}
+
+	u := graph.NewUndirected[int]()
+	u.AddEdge(0, 1)
+	u.AddEdge(2, 1)
+	u.AddEdge(0, 2)
+	if got := u.MaxFlow(0, 1); got != 2 {
+		t.Errorf("MaxFlow() on undirected graph = %d, want 2", got)
+	}
+}
+
+func TestMinCut(t *testing.T) {
+	got := clrsNetwork().MinCut("s", "t")
+	want := graph.Cut[string]{
+		Weight: 23,
+		Edges:  []graph.Edge[string]{{"v1", "v3", 12}, {"v4", "v3", 7}, {"v4", "t", 4}},
+		Source: []string{"s", "v1", "v2", "v4"},
+		Sink:   []string{"v3", "t"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MinCut() = %+v, want %+v", got, want)
+	}
+
+	for _, st := range [][2]string{{"s", "s"}, {"s", "none"}, {"none", "t"}} {
+		if got := clrsNetwork().MinCut(st[0], st[1]); !reflect.DeepEqual(got, graph.Cut[string]{}) {
+			t.Errorf("MinCut(%q, %q) = %+v, want zero Cut", st[0], st[1], got)
+		}
+	}
+}
+
+func TestGlobalMinCut(t *testing.T) {
+	g := graph.NewUndirected[int]()
+	for _, e := range []graph.Edge[int]{
+		{1, 2, 2}, {1, 5, 3}, {2, 3, 3}, {2, 5, 2}, {2, 6, 2}, {3, 4, 4},
+		{3, 7, 2}, {4, 7, 2}, {4, 8, 2}, {5, 6, 3}, {6, 7, 1}, {7, 8, 3},
+	} {
+		g.AddWeightedEdge(e.From, e.To, e.Weight)
+	}
+
+	got, err := g.GlobalMinCut()
+	if err != nil {
+		t.Fatalf("GlobalMinCut() error = %v", err)
+	}
+	if got.Source[0] != 1 {
+		got.Source, got.Sink = got.Sink, got.Source
+		for i, e := range got.Edges {
+			got.Edges[i].From, got.Edges[i].To = e.To, e.From
+		}
+	}
+	want := graph.Cut[int]{
+		Weight: 4,
+		Edges:  []graph.Edge[int]{{2, 3, 3}, {6, 7, 1}},
+		Source: []int{1, 2, 5, 6},
+		Sink:   []int{3, 4, 7, 8},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GlobalMinCut() = %+v, want %+v", got, want)
+	}
+
+	if _, err := clrsNetwork().GlobalMinCut(); !errors.Is(err, graph.ErrDirected) {
+		t.Errorf("GlobalMinCut() on directed graph error = %v, want %v", err, graph.ErrDirected)
+	}
+}
+
+func TestMaxMatching(t *testing.T) {
+	g := graph.FromAdjacency(map[string][]string{
+		"a": {"1", "2"},
+		"b": {"1"},
+		"c": {"2", "3"},
+		"d": {"3"},
+	}, false)
+
+	got := g.MaxMatching([]string{"a", "b", "c", "d"})
+	if len(got) != 3 {
+		t.Fatalf("MaxMatching() = %v, want size 3", got)
+	}
+	used := make(map[string]bool)
+	for l, r := range got {
+		if !g.HasEdge(l, r) || used[r] {
+			t.Errorf("MaxMatching() = %v, not a matching", got)
+		}
+		used[r] = true
+	}
+}
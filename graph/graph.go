@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Edge is an edge from node From to node To.
+//
+// Unweighted edges have weight 1.
+type Edge[N comparable] struct {
+	From, To N
+	Weight   int64
+}
+
+type arc struct {
+	to     int
+	weight int64
+}
+
+// Graph is a directed or undirected graph with optionally weighted edges.
+//
+// Nodes are kept in the order they are added, and every algorithm visits them in this order, so
+// results are deterministic for a deterministic construction. Parallel edges and self loops are
+// allowed. The zero value is not usable, call NewDirected or NewUndirected to create one.
+type Graph[N comparable] struct {
+	directed bool
+	nodes    []N
+	index    map[N]int
+	adj      [][]arc
+}
+
+// NewDirected creates an empty directed graph.
+func NewDirected[N comparable]() *Graph[N] {
+	return &Graph[N]{directed: true, index: make(map[N]int)}
+}
+
+// NewUndirected creates an empty undirected graph.
+func NewUndirected[N comparable]() *Graph[N] {
+	return &Graph[N]{index: make(map[N]int)}
+}
+
+// FromAdjacency creates a graph from an adjacency map, such as one parsed from input lines.
+//
+// Every key is connected to each node in its list with an unweighted edge. As map iteration order
+// is random, so is the order of nodes in the graph.
+func FromAdjacency[N comparable](adj map[N][]N, directed bool) *Graph[N] {
+	g := NewUndirected[N]()
+	g.directed = directed
+	for from, tos := range adj {
+		g.AddNode(from)
+		for _, to := range tos {
+			g.AddEdge(from, to)
+		}
+	}
+	return g
+}
+
+// Directed reports whether g is directed.
+func (g *Graph[N]) Directed() bool {
+	return g.directed
+}
+
+// Len returns the number of nodes.
+func (g *Graph[N]) Len() int {
+	return len(g.nodes)
+}
+
+// Nodes returns all nodes in the order they were added.
+func (g *Graph[N]) Nodes() []N {
+	return append([]N(nil), g.nodes...)
+}
+
+// HasNode reports whether n is a node of g.
+func (g *Graph[N]) HasNode(n N) bool {
+	_, ok := g.index[n]
+	return ok
+}
+
+// AddNode adds node n. It does nothing if n is already present.
+func (g *Graph[N]) AddNode(n N) {
+	g.id(n)
+}
+
+func (g *Graph[N]) id(n N) int {
+	if i, ok := g.index[n]; ok {
+		return i
+	}
+	i := len(g.nodes)
+	g.index[n] = i
+	g.nodes = append(g.nodes, n)
+	g.adj = append(g.adj, nil)
+	return i
+}
+
+// AddEdge adds an unweighted edge from node from to node to, adding missing nodes.
+//
+// In an undirected graph, the edge goes both ways.
+func (g *Graph[N]) AddEdge(from, to N) {
+	g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge with weight w from node from to node to, adding missing nodes.
+//
+// In an undirected graph, the edge goes both ways.
+func (g *Graph[N]) AddWeightedEdge(from, to N, w int64) {
+	u, v := g.id(from), g.id(to)
+	g.adj[u] = append(g.adj[u], arc{v, w})
+	if !g.directed && u != v {
+		g.adj[v] = append(g.adj[v], arc{u, w})
+	}
+}
+
+// HasEdge reports whether there is an edge from node from to node to.
+func (g *Graph[N]) HasEdge(from, to N) bool {
+	_, ok := g.Weight(from, to)
+	return ok
+}
+
+// Weight returns the weight of the first edge from node from to node to.
+//
+// The second return value is false if there is no such edge.
+func (g *Graph[N]) Weight(from, to N) (int64, bool) {
+	u, ok := g.index[from]
+	if !ok {
+		return 0, false
+	}
+	v, ok := g.index[to]
+	if !ok {
+		return 0, false
+	}
+	for _, a := range g.adj[u] {
+		if a.to == v {
+			return a.weight, true
+		}
+	}
+	return 0, false
+}
+
+// Neighbors returns the nodes that have an edge from n, in the order edges were added.
+func (g *Graph[N]) Neighbors(n N) []N {
+	u, ok := g.index[n]
+	if !ok {
+		return nil
+	}
+	ret := make([]N, len(g.adj[u]))
+	for i, a := range g.adj[u] {
+		ret[i] = g.nodes[a.to]
+	}
+	return ret
+}
+
+// EdgesFrom returns the edges leaving n, in the order they were added.
+func (g *Graph[N]) EdgesFrom(n N) []Edge[N] {
+	u, ok := g.index[n]
+	if !ok {
+		return nil
+	}
+	ret := make([]Edge[N], len(g.adj[u]))
+	for i, a := range g.adj[u] {
+		ret[i] = Edge[N]{n, g.nodes[a.to], a.weight}
+	}
+	return ret
+}
+
+// Edges returns all edges. In an undirected graph, each edge is returned once.
+func (g *Graph[N]) Edges() []Edge[N] {
+	var ret []Edge[N]
+	g.eachEdge(func(u, v int, w int64) {
+		ret = append(ret, Edge[N]{g.nodes[u], g.nodes[v], w})
+	})
+	return ret
+}
+
+func (g *Graph[N]) eachEdge(f func(u, v int, w int64)) {
+	for u, arcs := range g.adj {
+		for _, a := range arcs {
+			// An undirected edge is stored in both endpoints, report it from the lower one.
+			if g.directed || u <= a.to {
+				f(u, a.to, a.weight)
+			}
+		}
+	}
+}
+
+// String returns the graph in DOT format.
+func (g *Graph[N]) String() string {
+	b := strings.Builder{}
+	_ = g.WriteDOT(&b)
+	return b.String()
+}
+
+// WriteDOT writes the graph in Graphviz DOT format.
+//
+// Nodes are labeled with fmt.Sprint. Edge weights are written as labels, unless all edges are unweighted.
+func (g *Graph[N]) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	kind, op := "graph", "--"
+	if g.directed {
+		kind, op = "digraph", "->"
+	}
+
+	weighted := false
+	g.eachEdge(func(_, _ int, w int64) { weighted = weighted || w != 1 })
+
+	fmt.Fprintf(bw, "%s {\n", kind)
+	for _, n := range g.nodes {
+		fmt.Fprintf(bw, "\t%s;\n", strconv.Quote(fmt.Sprint(n)))
+	}
+	g.eachEdge(func(u, v int, w int64) {
+		fmt.Fprintf(bw, "\t%s %s %s", strconv.Quote(fmt.Sprint(g.nodes[u])), op, strconv.Quote(fmt.Sprint(g.nodes[v])))
+		if weighted {
+			fmt.Fprintf(bw, " [label=%d]", w)
+		}
+		bw.WriteString(";\n")
+	})
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
@@ -0,0 +1,160 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/Xiangze-Li/golang-util/graph"
+)
+
+func TestGraph(t *testing.T) {
+	g := graph.NewUndirected[string]()
+	g.AddEdge("a", "b")
+	g.AddWeightedEdge("b", "c", 5)
+	g.AddNode("d")
+
+	if got, want := g.Nodes(), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Nodes() = %v, want %v", got, want)
+	}
+	if got, want := g.Neighbors("b"), []string{"a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbors(b) = %v, want %v", got, want)
+	}
+	if w, ok := g.Weight("c", "b"); !ok || w != 5 {
+		t.Errorf("Weight(c, b) = %d, %v, want 5, true", w, ok)
+	}
+	if g.HasEdge("a", "c") {
+		t.Errorf("HasEdge(a, c) = true, want false")
+	}
+	want := []graph.Edge[string]{{"a", "b", 1}, {"b", "c", 5}}
+	if got := g.Edges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Edges() = %v, want %v", got, want)
+	}
+
+	wantDOT := "graph {\n\t\"a\";\n\t\"b\";\n\t\"c\";\n\t\"d\";\n" +
+		"\t\"a\" -- \"b\" [label=1];\n\t\"b\" -- \"c\" [label=5];\n}\n"
+	if got := g.String(); got != wantDOT {
+		t.Errorf("String() = %q, want %q", got, wantDOT)
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodes     []int
+		edges     [][2]int
+		want      []int
+		wantCycle []int
+	}{
+		{
+			name:  "Chain",
+			edges: [][2]int{{3, 2}, {2, 1}, {1, 0}},
+			want:  []int{3, 2, 1, 0},
+		},
+		{
+			name:  "Diamond",
+			edges: [][2]int{{0, 1}, {0, 2}, {1, 3}, {2, 3}},
+			want:  []int{0, 1, 2, 3},
+		},
+		{
+			name:  "Earliest added first",
+			nodes: []int{0, 1, 2, 3},
+			edges: [][2]int{{0, 3}, {1, 2}},
+			want:  []int{0, 1, 2, 3},
+		},
+		{
+			name:      "Cycle",
+			edges:     [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 1}},
+			wantCycle: []int{1, 2, 3},
+		},
+		{
+			name:      "Self loop",
+			edges:     [][2]int{{0, 1}, {1, 1}},
+			wantCycle: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := graph.NewDirected[int]()
+			for _, n := range tt.nodes {
+				g.AddNode(n)
+			}
+			for _, e := range tt.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			got, err := g.TopoSort()
+			var cycleErr *graph.CycleError[int]
+			if errors.As(err, &cycleErr) {
+				if !reflect.DeepEqual(cycleErr.Cycle, tt.wantCycle) {
+					t.Errorf("TopoSort() cycle = %v, want %v", cycleErr.Cycle, tt.wantCycle)
+				}
+				return
+			}
+			if err != nil || tt.wantCycle != nil {
+				t.Fatalf("TopoSort() error = %v, want cycle %v", err, tt.wantCycle)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopoSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := graph.NewUndirected[int]().TopoSort(); !errors.Is(err, graph.ErrUndirected) {
+		t.Errorf("TopoSort() on undirected graph error = %v, want %v", err, graph.ErrUndirected)
+	}
+}
+
+func TestSCC(t *testing.T) {
+	g := graph.NewDirected[int]()
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 3}, {5, 4}} {
+		g.AddEdge(e[0], e[1])
+	}
+
+	got := g.SCC()
+	for _, c := range got {
+		slices.Sort(c)
+	}
+	if want := [][]int{{3, 4}, {0, 1, 2}, {5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SCC() = %v, want %v", got, want)
+	}
+
+	if got, want := g.Components(), [][]int{{0, 1, 2, 3, 4, 5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	g := graph.FromAdjacency(map[string][]string{"a": {"b"}, "c": {"d", "e"}}, false)
+	g.AddNode("f")
+
+	got := g.Components()
+	for _, c := range got {
+		slices.Sort(c)
+	}
+	slices.SortFunc(got, func(l, r []string) int { return slices.Compare(l, r) })
+	if want := [][]string{{"a", "b"}, {"c", "d", "e"}, {"f"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+}
+
+func TestReachability(t *testing.T) {
+	g := graph.NewDirected[int]()
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 1)
+
+	if got, want := g.Reachable(1), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reachable(1) = %v, want %v", got, want)
+	}
+
+	want := map[int]map[int]bool{
+		0: {0: true, 1: true, 2: true},
+		1: {1: true, 2: true},
+		2: {2: true},
+		3: {3: true, 1: true, 2: true},
+	}
+	if got := g.Reachability(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Reachability() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,32 @@
+package graph
+
+import (
+	"strings"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+// Parse parses adjacency lines, as read by util.GetLines, into a graph with unweighted edges.
+//
+// Unlike FromAdjacency, nodes and edges are added in the order they appear in lines. Blank lines are
+// skipped. If a line is malformed, a *util.ParseError is returned, wrapping the error of spec.ParseLine.
+func Parse(lines []string, spec util.EdgeSpec, directed bool) (*Graph[string], error) {
+	g := NewUndirected[string]()
+	if directed {
+		g = NewDirected[string]()
+	}
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		key, targets, err := spec.ParseLine(line)
+		if err != nil {
+			return nil, &util.ParseError{Index: i, Str: line, Err: err}
+		}
+		g.AddNode(key)
+		for _, t := range targets {
+			g.AddEdge(key, t)
+		}
+	}
+	return g, nil
+}
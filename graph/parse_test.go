@@ -0,0 +1,32 @@
+package graph_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+	"github.com/Xiangze-Li/golang-util/graph"
+)
+
+func TestParse(t *testing.T) {
+	g, err := graph.Parse([]string{"a: b c", "", "d: a"}, util.EdgeSpec{KeySep: ":"}, true)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got, want := g.Nodes(), []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() nodes = %v, want %v", got, want)
+	}
+	if got, want := g.Neighbors("a"), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() neighbors of a = %v, want %v", got, want)
+	}
+	if !g.Directed() || g.HasEdge("b", "a") {
+		t.Errorf("Parse() graph is not directed")
+	}
+
+	_, err = graph.Parse([]string{"a: b", "a b"}, util.EdgeSpec{KeySep: ":"}, false)
+	var parseErr *util.ParseError
+	if !errors.Is(err, util.ErrMissingSeparator) || !errors.As(err, &parseErr) || parseErr.Index != 1 {
+		t.Errorf("Parse() error = %v, want *ParseError at index 1 wrapping %v", err, util.ErrMissingSeparator)
+	}
+}
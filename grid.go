@@ -0,0 +1,99 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is wrapped by errors of searches that find nothing.
+	ErrNotFound = errors.New("not found")
+	// ErrDuplicate is wrapped by errors of searches expecting a unique result but finding more.
+	ErrDuplicate = errors.New("found more than once")
+)
+
+// FindAllFunc returns positions of all cells in grid satisfying pred, in row-major order.
+//
+// In returned points, X is the row index `i` and Y is the column index `j`.
+func FindAllFunc[T any](grid [][]T, pred func(T) bool) []Point2I[int] {
+	var ret []Point2I[int]
+	for i, row := range grid {
+		for j, e := range row {
+			if pred(e) {
+				ret = append(ret, Point2I[int]{i, j})
+			}
+		}
+	}
+	return ret
+}
+
+// FindAll returns positions of all bytes b in grid, in row-major order.
+func FindAll(grid [][]byte, b byte) []Point2I[int] {
+	return FindAllFunc(grid, func(e byte) bool { return e == b })
+}
+
+// FindUniqueFunc returns the position of the only cell in grid satisfying pred.
+//
+// If there is no such cell, an error wrapping ErrNotFound is returned. If there are more than one,
+// an error wrapping ErrDuplicate is returned.
+func FindUniqueFunc[T any](grid [][]T, pred func(T) bool) (Point2I[int], error) {
+	found := FindAllFunc(grid, pred)
+	switch len(found) {
+	case 0:
+		return Point2I[int]{}, ErrNotFound
+	case 1:
+		return found[0], nil
+	default:
+		return Point2I[int]{}, fmt.Errorf("%w: at %v and %v", ErrDuplicate, found[0], found[1])
+	}
+}
+
+// FindUnique returns the position of the only byte b in grid, such as the start 'S' or the end 'E'.
+//
+// Errors are reported in the same way as FindUniqueFunc.
+func FindUnique(grid [][]byte, b byte) (Point2I[int], error) {
+	p, err := FindUniqueFunc(grid, func(e byte) bool { return e == b })
+	if err != nil {
+		return p, fmt.Errorf("byte %q: %w", b, err)
+	}
+	return p, nil
+}
+
+// SplitWalls splits positions of grid into walls, the cells holding byte wall, and open cells, the others.
+func SplitWalls(grid [][]byte, wall byte) (walls, open map[Point2I[int]]bool) {
+	walls = ToVis(FindAll(grid, wall))
+	open = ToVis(FindAllFunc(grid, func(e byte) bool { return e != wall }))
+	return walls, open
+}
+
+// DigitGrid converts a grid of decimal digits into a grid of integers.
+//
+// If a cell is not a digit, an error reporting its position is returned.
+func DigitGrid[T Integer](grid [][]byte) ([][]T, error) {
+	ret := make([][]T, len(grid))
+	for i, row := range grid {
+		ret[i] = make([]T, len(row))
+		for j, b := range row {
+			if b < '0' || b > '9' {
+				return nil, fmt.Errorf("invalid digit %q at %v", b, Point2I[int]{i, j})
+			}
+			ret[i][j] = T(b - '0')
+		}
+	}
+	return ret, nil
+}
+
+// WrapGet returns the cell of grid at p, with grid repeated infinitely in both directions.
+// grid must be rectangular and non-empty.
+func WrapGet[T any](grid [][]T, p Point2I[int]) T {
+	local := p.Mod(Point2I[int]{len(grid), len(grid[0])})
+	return grid[local.X][local.Y]
+}
+
+// Tile locates p in grid repeated infinitely in both directions. It returns the index of the copy
+// holding p, with the original grid being tile (0, 0), and the position of p within that copy.
+// grid must be rectangular and non-empty.
+func Tile[T any](grid [][]T, p Point2I[int]) (tile, local Point2I[int]) {
+	size := Point2I[int]{len(grid), len(grid[0])}
+	return p.FloorDiv(size), p.Mod(size)
+}
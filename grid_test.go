@@ -0,0 +1,80 @@
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestFindAll(t *testing.T) {
+	grid := [][]byte{
+		[]byte("#S.#"),
+		[]byte("#..#"),
+		[]byte("#.E#"),
+	}
+	type pt = util.Point2I[int]
+
+	if got, want := util.FindAll(grid, '#'), []pt{{0, 0}, {0, 3}, {1, 0}, {1, 3}, {2, 0}, {2, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll() = %v, want %v", got, want)
+	}
+	if got := util.FindAll(grid, 'x'); got != nil {
+		t.Errorf("FindAll() = %v, want nil", got)
+	}
+
+	tests := []struct {
+		b       byte
+		want    pt
+		wantErr error
+	}{
+		{b: 'S', want: pt{0, 1}},
+		{b: 'E', want: pt{2, 2}},
+		{b: 'x', wantErr: util.ErrNotFound},
+		{b: '.', wantErr: util.ErrDuplicate},
+	}
+	for _, tt := range tests {
+		got, err := util.FindUnique(grid, tt.b)
+		if !errors.Is(err, tt.wantErr) || (err == nil && got != tt.want) {
+			t.Errorf("FindUnique(%q) = %v, %v, want %v, %v", tt.b, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	walls, open := util.SplitWalls(grid, '#')
+	if len(walls) != 6 || len(open) != 6 || !walls[pt{1, 3}] || !open[pt{0, 1}] || open[pt{0, 0}] {
+		t.Errorf("SplitWalls() = %v, %v", walls, open)
+	}
+}
+
+func TestDigitGrid(t *testing.T) {
+	got, err := util.DigitGrid[int]([][]byte{[]byte("012"), []byte("987")})
+	if want := [][]int{{0, 1, 2}, {9, 8, 7}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("DigitGrid() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := util.DigitGrid[uint8]([][]byte{[]byte("01"), []byte("2.")}); err == nil {
+		t.Errorf("DigitGrid() error = nil, want error")
+	}
+}
+
+func TestTile(t *testing.T) {
+	type pt = util.Point2I[int]
+	grid := [][]byte{[]byte("abc"), []byte("def")}
+	tests := []struct {
+		p           pt
+		want        byte
+		tile, local pt
+	}{
+		{p: pt{1, 2}, want: 'f', tile: pt{0, 0}, local: pt{1, 2}},
+		{p: pt{-1, -1}, want: 'f', tile: pt{-1, -1}, local: pt{1, 2}},
+		{p: pt{4, -3}, want: 'a', tile: pt{2, -1}, local: pt{0, 0}},
+		{p: pt{-3, 7}, want: 'e', tile: pt{-2, 2}, local: pt{1, 1}},
+	}
+	for _, tt := range tests {
+		if got := util.WrapGet(grid, tt.p); got != tt.want {
+			t.Errorf("WrapGet(%v) = %c, want %c", tt.p, got, tt.want)
+		}
+		if tile, local := util.Tile(grid, tt.p); tile != tt.tile || local != tt.local {
+			t.Errorf("Tile(%v) = %v, %v, want %v, %v", tt.p, tile, local, tt.tile, tt.local)
+		}
+	}
+}
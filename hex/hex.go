@@ -0,0 +1,209 @@
+package hex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	util "github.com/Xiangze-Li/golang-util"
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+// ErrDirection is wrapped by errors of directions that are unknown or invalid for a layout.
+var ErrDirection = errors.New("invalid hex direction")
+
+// Layout is the orientation of hexagons, which decides the names of the 6 directions.
+type Layout byte
+
+const (
+	// PointyTop hexagons form horizontal rows. Directions are E, NE, NW, W, SW and SE.
+	PointyTop Layout = iota
+	// FlatTop hexagons form vertical columns. Directions are N, NE, SE, S, SW and NW.
+	FlatTop
+)
+
+// DeltaPointy is a map of 6 directions of PointyTop layout to their axial delta {Q, R}.
+var DeltaPointy = map[c.Direction][2]int{
+	c.E:  {1, 0},
+	c.NE: {1, -1},
+	c.NW: {0, -1},
+	c.W:  {-1, 0},
+	c.SW: {-1, 1},
+	c.SE: {0, 1},
+}
+
+// DeltaFlat is a map of 6 directions of FlatTop layout to their axial delta {Q, R}.
+var DeltaFlat = map[c.Direction][2]int{
+	c.N:  {0, -1},
+	c.NE: {1, -1},
+	c.SE: {1, 0},
+	c.S:  {0, 1},
+	c.SW: {-1, 1},
+	c.NW: {-1, 0},
+}
+
+// Deltas returns the map of directions to axial deltas of the layout.
+func (l Layout) Deltas() map[c.Direction][2]int {
+	if l == FlatTop {
+		return DeltaFlat
+	}
+	return DeltaPointy
+}
+
+// axial lists the 6 axial deltas counter-clockwise, starting from +Q.
+var axial = [6][2]int{{1, 0}, {1, -1}, {0, -1}, {-1, 0}, {-1, 1}, {0, 1}}
+
+var names = map[string]c.Direction{
+	"n": c.N, "ne": c.NE, "e": c.E, "se": c.SE,
+	"s": c.S, "sw": c.SW, "w": c.W, "nw": c.NW,
+}
+
+// ParseDirection converts a direction name like "ne" or "SW" to a Direction. Case is ignored.
+func ParseDirection(s string) (c.Direction, error) {
+	if d, ok := names[strings.ToLower(s)]; ok {
+		return d, nil
+	}
+	return c.C, fmt.Errorf("%w: %q", ErrDirection, s)
+}
+
+// ParsePath converts a sequence of direction names to Directions.
+//
+// Names may be separated by commas or spaces, like "ne,ne,s", or concatenated, like "esenee".
+// Two-letter names are matched first, so "ne" is NE rather than N followed by E.
+func ParsePath(s string) ([]c.Direction, error) {
+	s = strings.ToLower(s)
+	var ret []c.Direction
+	for i := 0; i < len(s); {
+		if s[i] == ',' || s[i] == ' ' {
+			i++
+			continue
+		}
+		if i+1 < len(s) && (s[i] == 'n' || s[i] == 's') {
+			if d, ok := names[s[i:i+2]]; ok {
+				ret = append(ret, d)
+				i += 2
+				continue
+			}
+		}
+		d, err := ParseDirection(s[i : i+1])
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, d)
+		i++
+	}
+	return ret, nil
+}
+
+// Hex[T] is a hexagon in axial coordinates. The implied cube coordinate S is -Q-R.
+type Hex[T util.SignedInteger] struct {
+	Q, R T
+}
+
+// S returns the third cube coordinate, so that Q+R+S == 0.
+func (h Hex[T]) S() T {
+	return -h.Q - h.R
+}
+
+// Add returns the sum of two hexes as vectors.
+func (h Hex[T]) Add(o Hex[T]) Hex[T] {
+	return Hex[T]{h.Q + o.Q, h.R + o.R}
+}
+
+// AddCord returns h moved by axial delta {Q, R}.
+func (h Hex[T]) AddCord(d [2]T) Hex[T] {
+	return Hex[T]{h.Q + d[0], h.R + d[1]}
+}
+
+// Sub returns the difference of two hexes as vectors.
+func (h Hex[T]) Sub(o Hex[T]) Hex[T] {
+	return Hex[T]{h.Q - o.Q, h.R - o.R}
+}
+
+// Mul returns the product of a hex and an integer.
+func (h Hex[T]) Mul(n T) Hex[T] {
+	return Hex[T]{h.Q * n, h.R * n}
+}
+
+// Step returns the neighbor of h in direction d of layout l.
+// If d is not a direction of l, this function panics.
+func (h Hex[T]) Step(d c.Direction, l Layout) Hex[T] {
+	delta, ok := l.Deltas()[d]
+	if !ok {
+		panic(fmt.Errorf("%w: %v in layout %v", ErrDirection, d, l))
+	}
+	return Hex[T]{h.Q + T(delta[0]), h.R + T(delta[1])}
+}
+
+// Len returns the distance from h to the origin.
+func (h Hex[T]) Len() T {
+	return (util.Abs(h.Q) + util.Abs(h.R) + util.Abs(h.S())) / 2
+}
+
+// Distance returns the number of steps between two hexes.
+func (h Hex[T]) Distance(o Hex[T]) T {
+	return h.Sub(o).Len()
+}
+
+// Neighbors returns the 6 neighbors of h, counter-clockwise starting from +Q.
+func (h Hex[T]) Neighbors() []Hex[T] {
+	ret := make([]Hex[T], 0, len(axial))
+	for _, d := range axial {
+		ret = append(ret, Hex[T]{h.Q + T(d[0]), h.R + T(d[1])})
+	}
+	return ret
+}
+
+// Ring returns all hexes at distance radius from center, counter-clockwise.
+// A ring of radius 0 is center itself, and a negative radius gives nil.
+func Ring[T util.SignedInteger](center Hex[T], radius T) []Hex[T] {
+	if radius < 0 {
+		return nil
+	}
+	if radius == 0 {
+		return []Hex[T]{center}
+	}
+	ret := make([]Hex[T], 0, 6*int(radius))
+	d := axial[4]
+	h := center.AddCord([2]T{T(d[0]) * radius, T(d[1]) * radius})
+	for _, d := range axial {
+		for i := T(0); i < radius; i++ {
+			ret = append(ret, h)
+			h = h.AddCord([2]T{T(d[0]), T(d[1])})
+		}
+	}
+	return ret
+}
+
+// Range returns all hexes within distance radius from center, ring by ring from the center.
+func Range[T util.SignedInteger](center Hex[T], radius T) []Hex[T] {
+	if radius < 0 {
+		return nil
+	}
+	var ret []Hex[T]
+	for r := T(0); ; r++ {
+		ret = append(ret, Ring(center, r)...)
+		if r == radius {
+			return ret
+		}
+	}
+}
+
+// ToOffset converts h to offset coordinates for rendering. X is the row index, Y is the column index.
+//
+// PointyTop uses "odd-r" offset, where odd rows are shoved right. FlatTop uses "odd-q" offset, where
+// odd columns are shoved down.
+func (h Hex[T]) ToOffset(l Layout) util.Point2I[T] {
+	if l == FlatTop {
+		return util.Point2I[T]{X: h.R + (h.Q-h.Q&1)/2, Y: h.Q}
+	}
+	return util.Point2I[T]{X: h.R, Y: h.Q + (h.R-h.R&1)/2}
+}
+
+// FromOffset converts offset coordinates back to a hex. It is the inverse of Hex.ToOffset.
+func FromOffset[T util.SignedInteger](p util.Point2I[T], l Layout) Hex[T] {
+	if l == FlatTop {
+		return Hex[T]{Q: p.Y, R: p.X - (p.Y-p.Y&1)/2}
+	}
+	return Hex[T]{Q: p.Y - (p.X-p.X&1)/2, R: p.X}
+}
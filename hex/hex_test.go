@@ -0,0 +1,138 @@
+package hex_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+	c "github.com/Xiangze-Li/golang-util/constants"
+	"github.com/Xiangze-Li/golang-util/hex"
+)
+
+func walk(t *testing.T, path string, l hex.Layout) hex.Hex[int] {
+	t.Helper()
+	dirs, err := hex.ParsePath(path)
+	if err != nil {
+		t.Fatalf("ParsePath(%q) error = %v", path, err)
+	}
+	h := hex.Hex[int]{}
+	for _, d := range dirs {
+		h = h.Step(d, l)
+	}
+	return h
+}
+
+func TestParsePath(t *testing.T) {
+	got, err := hex.ParsePath("esenee")
+	if want := []c.Direction{c.E, c.SE, c.NE, c.E}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePath() = %v, %v, want %v", got, err, want)
+	}
+	got, err = hex.ParsePath("NE,s, nw")
+	if want := []c.Direction{c.NE, c.S, c.NW}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePath() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := hex.ParsePath("nex"); !errors.Is(err, hex.ErrDirection) {
+		t.Errorf("ParsePath() error = %v, want ErrDirection", err)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		path   string
+		layout hex.Layout
+		want   int
+	}{
+		{path: "ne,ne,ne", layout: hex.FlatTop, want: 3},
+		{path: "ne,ne,sw,sw", layout: hex.FlatTop, want: 0},
+		{path: "ne,ne,s,s", layout: hex.FlatTop, want: 2},
+		{path: "se,sw,se,sw,sw", layout: hex.FlatTop, want: 3},
+		{path: "nwwswee", layout: hex.PointyTop, want: 0},
+		{path: "esew", layout: hex.PointyTop, want: 1},
+		{path: "eeenw", layout: hex.PointyTop, want: 3},
+	}
+	for _, tt := range tests {
+		if got := walk(t, tt.path, tt.layout).Len(); got != tt.want {
+			t.Errorf("distance of %q = %d, want %d", tt.path, got, tt.want)
+		}
+	}
+	if got := walk(t, "esew", hex.PointyTop); got != (hex.Hex[int]{Q: 0, R: 1}) {
+		t.Errorf("walk(esew) = %v, want SE neighbor of origin", got)
+	}
+}
+
+func TestStepPanics(t *testing.T) {
+	defer func() {
+		if err, _ := recover().(error); !errors.Is(err, hex.ErrDirection) {
+			t.Errorf("Step(N, PointyTop) panics with %v, want ErrDirection", err)
+		}
+	}()
+	hex.Hex[int]{}.Step(c.N, hex.PointyTop)
+}
+
+func TestRing(t *testing.T) {
+	center := hex.Hex[int8]{Q: 2, R: -1}
+	if got := hex.Ring(center, 0); !reflect.DeepEqual(got, []hex.Hex[int8]{center}) {
+		t.Errorf("Ring(0) = %v, want center", got)
+	}
+	for r := int8(1); r <= 3; r++ {
+		ring := hex.Ring(center, r)
+		seen := make(map[hex.Hex[int8]]bool)
+		for _, h := range ring {
+			if h.Distance(center) != r {
+				t.Errorf("Ring(%d) contains %v at distance %d", r, h, h.Distance(center))
+			}
+			seen[h] = true
+		}
+		if len(ring) != 6*int(r) || len(seen) != len(ring) {
+			t.Errorf("Ring(%d) has %d hexes, %d distinct, want %d", r, len(ring), len(seen), 6*r)
+		}
+	}
+	ring := make(map[hex.Hex[int8]]bool)
+	for _, h := range hex.Ring(center, 1) {
+		ring[h] = true
+	}
+	for _, h := range center.Neighbors() {
+		if !ring[h] {
+			t.Errorf("Ring(1) misses neighbor %v", h)
+		}
+	}
+	if got := hex.Ring(center, -1); got != nil {
+		t.Errorf("Ring(-1) = %v, want nil", got)
+	}
+	if got := hex.Range(center, -1); got != nil {
+		t.Errorf("Range(-1) = %v, want nil", got)
+	}
+	if got := len(hex.Range(center, 3)); got != 37 {
+		t.Errorf("len(Range(3)) = %d, want 37", got)
+	}
+	if got := len(hex.Range(hex.Hex[int8]{}, 127)); got != 48769 {
+		t.Errorf("len(Range(127)) = %d, want 48769", got)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	tests := []struct {
+		h      hex.Hex[int]
+		layout hex.Layout
+		want   util.Point2I[int]
+	}{
+		{h: hex.Hex[int]{Q: 0, R: 1}, layout: hex.PointyTop, want: util.Point2I[int]{X: 1, Y: 0}},
+		{h: hex.Hex[int]{Q: -1, R: 2}, layout: hex.PointyTop, want: util.Point2I[int]{X: 2, Y: 0}},
+		{h: hex.Hex[int]{Q: 1, R: -1}, layout: hex.PointyTop, want: util.Point2I[int]{X: -1, Y: 0}},
+		{h: hex.Hex[int]{Q: 1, R: 0}, layout: hex.FlatTop, want: util.Point2I[int]{X: 0, Y: 1}},
+		{h: hex.Hex[int]{Q: -1, R: 0}, layout: hex.FlatTop, want: util.Point2I[int]{X: -1, Y: -1}},
+	}
+	for _, tt := range tests {
+		if got := tt.h.ToOffset(tt.layout); got != tt.want {
+			t.Errorf("%v.ToOffset(%v) = %v, want %v", tt.h, tt.layout, got, tt.want)
+		}
+	}
+	for _, l := range []hex.Layout{hex.PointyTop, hex.FlatTop} {
+		for _, h := range hex.Range(hex.Hex[int]{}, 4) {
+			if got := hex.FromOffset(h.ToOffset(l), l); got != h {
+				t.Errorf("FromOffset(ToOffset(%v)) = %v in layout %v", h, got, l)
+			}
+		}
+	}
+}
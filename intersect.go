@@ -0,0 +1,223 @@
+package util
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// IntersectionKind classifies the relation between two lines, rays or segments.
+type IntersectionKind int
+
+const (
+	// Disjoint means the objects have no common point, though their lines either cross or coincide.
+	// This happens when the crossing point is out of range of a ray or segment, or when collinear
+	// rays or segments do not overlap.
+	Disjoint IntersectionKind = iota
+	// Crossing means the objects meet at exactly one point.
+	Crossing
+	// Parallel means the objects lie on distinct parallel lines.
+	Parallel
+	// Collinear means the objects lie on the same line and share at least one point.
+	Collinear
+	// Skew means the objects lie on non-parallel lines that do not meet. Only possible in 3D.
+	Skew
+)
+
+func (k IntersectionKind) String() string {
+	switch k {
+	case Disjoint:
+		return "Disjoint"
+	case Crossing:
+		return "Crossing"
+	case Parallel:
+		return "Parallel"
+	case Collinear:
+		return "Collinear"
+	case Skew:
+		return "Skew"
+	default:
+		return "IntersectionKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Intersection is the exact result of intersecting two lines, rays or segments.
+//
+// The first object is p1 + T*d1 and the second one is p2 + U*d2. For segments, d is the vector
+// from the start point to the end point.
+type Intersection struct {
+	Kind IntersectionKind
+	// T and U are the parameters of the crossing point of the underlying lines.
+	// They are only set if Kind is Crossing, or Disjoint with non-parallel lines. They are nil for
+	// collinear rays or segments that do not overlap, since there is no single crossing point.
+	T, U *big.Rat
+	// Point holds the coordinates of the crossing point. It is only set if Kind is Crossing.
+	Point []*big.Rat
+}
+
+type vec3 [3]*big.Int
+
+func newVec3[T SignedInteger](x, y, z T) vec3 {
+	return vec3{big.NewInt(int64(x)), big.NewInt(int64(y)), big.NewInt(int64(z))}
+}
+
+func (a vec3) sub(b vec3) vec3 {
+	var r vec3
+	for i := range r {
+		r[i] = new(big.Int).Sub(a[i], b[i])
+	}
+	return r
+}
+
+func (a vec3) dot(b vec3) *big.Int {
+	r := new(big.Int)
+	for i := range a {
+		r.Add(r, new(big.Int).Mul(a[i], b[i]))
+	}
+	return r
+}
+
+func (a vec3) cross(b vec3) vec3 {
+	cr := func(i, j int) *big.Int {
+		return new(big.Int).Sub(new(big.Int).Mul(a[i], b[j]), new(big.Int).Mul(a[j], b[i]))
+	}
+	return vec3{cr(1, 2), cr(2, 0), cr(0, 1)}
+}
+
+func (a vec3) isZero() bool {
+	return a[0].Sign() == 0 && a[1].Sign() == 0 && a[2].Sign() == 0
+}
+
+// paramRange limits the parameter along a ray or segment. Lines have no limit.
+type paramRange int
+
+const (
+	lineRange paramRange = iota
+	rayRange
+	segmentRange
+)
+
+func (r paramRange) contains(t *big.Rat) bool {
+	switch r {
+	case rayRange:
+		return t.Sign() >= 0
+	case segmentRange:
+		return t.Sign() >= 0 && t.Cmp(big.NewRat(1, 1)) <= 0
+	default:
+		return true
+	}
+}
+
+// overlaps reports whether a collinear second object, whose parameters along the first one span
+// from s to s + e, shares a point with the first one.
+func (r paramRange) overlaps(s, e *big.Rat) bool {
+	switch r {
+	case rayRange:
+		// The second ray starts at s and goes towards the sign of e.
+		return s.Sign() >= 0 || e.Sign() > 0
+	case segmentRange:
+		lo, hi := s, new(big.Rat).Add(s, e)
+		if lo.Cmp(hi) > 0 {
+			lo, hi = hi, lo
+		}
+		return hi.Sign() >= 0 && lo.Cmp(big.NewRat(1, 1)) <= 0
+	default:
+		return true
+	}
+}
+
+// intersect intersects p1 + t*d1 and p2 + u*d2. Direction vectors must not be zero.
+func intersect(p1, d1, p2, d2 vec3, dim int, r paramRange) Intersection {
+	w := p2.sub(p1)
+	n := d1.cross(d2)
+
+	if n.isZero() {
+		if !w.cross(d1).isZero() {
+			return Intersection{Kind: Parallel}
+		}
+		dd := d1.dot(d1)
+		s := new(big.Rat).SetFrac(w.dot(d1), dd)
+		e := new(big.Rat).SetFrac(d2.dot(d1), dd)
+		if r.overlaps(s, e) {
+			return Intersection{Kind: Collinear}
+		}
+		return Intersection{Kind: Disjoint}
+	}
+
+	if w.dot(n).Sign() != 0 {
+		return Intersection{Kind: Skew}
+	}
+
+	nn := n.dot(n)
+	ret := Intersection{
+		Kind: Disjoint,
+		T:    new(big.Rat).SetFrac(w.cross(d2).dot(n), nn),
+		U:    new(big.Rat).SetFrac(w.cross(d1).dot(n), nn),
+	}
+	if !r.contains(ret.T) || !r.contains(ret.U) {
+		return ret
+	}
+
+	ret.Kind = Crossing
+	ret.Point = make([]*big.Rat, dim)
+	for i := range ret.Point {
+		ret.Point[i] = new(big.Rat).Mul(ret.T, new(big.Rat).SetInt(d1[i]))
+		ret.Point[i].Add(ret.Point[i], new(big.Rat).SetInt(p1[i]))
+	}
+	return ret
+}
+
+func vec2I[T SignedInteger](p Point2I[T]) vec3 { return newVec3(p.X, p.Y, 0) }
+
+func vec3I[T SignedInteger](p Point3I[T]) vec3 { return newVec3(p.X, p.Y, p.Z) }
+
+func intersect2I[T SignedInteger](p1, d1, p2, d2 Point2I[T], r paramRange) Intersection {
+	return intersect(vec2I(p1), vec2I(d1), vec2I(p2), vec2I(d2), 2, r)
+}
+
+func intersect3I[T SignedInteger](p1, d1, p2, d2 Point3I[T], r paramRange) Intersection {
+	return intersect(vec3I(p1), vec3I(d1), vec3I(p2), vec3I(d2), 3, r)
+}
+
+// LineIntersection2I intersects the line through p1 with direction d1 and the line through p2 with direction d2.
+//
+// Directions must not be zero vectors. All arithmetic is exact.
+func LineIntersection2I[T SignedInteger](p1, d1, p2, d2 Point2I[T]) Intersection {
+	return intersect2I(p1, d1, p2, d2, lineRange)
+}
+
+// RayIntersection2I intersects the ray from p1 with direction d1 and the ray from p2 with direction d2.
+//
+// Directions must not be zero vectors. All arithmetic is exact.
+func RayIntersection2I[T SignedInteger](p1, d1, p2, d2 Point2I[T]) Intersection {
+	return intersect2I(p1, d1, p2, d2, rayRange)
+}
+
+// SegmentIntersection2I intersects the segment from a1 to b1 and the segment from a2 to b2.
+//
+// Segments must not be degenerate to a point. All arithmetic is exact.
+func SegmentIntersection2I[T SignedInteger](a1, b1, a2, b2 Point2I[T]) Intersection {
+	p1, p2 := vec2I(a1), vec2I(a2)
+	return intersect(p1, vec2I(b1).sub(p1), p2, vec2I(b2).sub(p2), 2, segmentRange)
+}
+
+// LineIntersection3I intersects the line through p1 with direction d1 and the line through p2 with direction d2.
+//
+// Directions must not be zero vectors. All arithmetic is exact.
+func LineIntersection3I[T SignedInteger](p1, d1, p2, d2 Point3I[T]) Intersection {
+	return intersect3I(p1, d1, p2, d2, lineRange)
+}
+
+// RayIntersection3I intersects the ray from p1 with direction d1 and the ray from p2 with direction d2.
+//
+// Directions must not be zero vectors. All arithmetic is exact.
+func RayIntersection3I[T SignedInteger](p1, d1, p2, d2 Point3I[T]) Intersection {
+	return intersect3I(p1, d1, p2, d2, rayRange)
+}
+
+// SegmentIntersection3I intersects the segment from a1 to b1 and the segment from a2 to b2.
+//
+// Segments must not be degenerate to a point. All arithmetic is exact.
+func SegmentIntersection3I[T SignedInteger](a1, b1, a2, b2 Point3I[T]) Intersection {
+	p1, p2 := vec3I(a1), vec3I(a2)
+	return intersect(p1, vec3I(b1).sub(p1), p2, vec3I(b2).sub(p2), 3, segmentRange)
+}
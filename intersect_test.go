@@ -0,0 +1,119 @@
+package util_test
+
+import (
+	"math/big"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func checkIntersection(t *testing.T, got util.Intersection, kind util.IntersectionKind, point ...string) {
+	t.Helper()
+	if got.Kind != kind {
+		t.Errorf("Kind = %v, want %v", got.Kind, kind)
+		return
+	}
+	if len(got.Point) != len(point) {
+		t.Errorf("Point = %v, want %v", got.Point, point)
+		return
+	}
+	for i, s := range point {
+		want, _ := new(big.Rat).SetString(s)
+		if got.Point[i].Cmp(want) != 0 {
+			t.Errorf("Point[%d] = %v, want %v", i, got.Point[i], want)
+		}
+	}
+}
+
+func TestIntersection2I(t *testing.T) {
+	type pt = util.Point2I[int64]
+	tests := []struct {
+		name  string
+		exec  func() util.Intersection
+		kind  util.IntersectionKind
+		point []string
+	}{
+		{
+			name:  "Rays crossing",
+			exec:  func() util.Intersection { return util.RayIntersection2I(pt{19, 13}, pt{-2, 1}, pt{18, 19}, pt{-1, -1}) },
+			kind:  util.Crossing,
+			point: []string{"43/3", "46/3"},
+		},
+		{
+			name: "Rays crossing in the past",
+			exec: func() util.Intersection { return util.RayIntersection2I(pt{19, 13}, pt{-2, 1}, pt{20, 19}, pt{1, -5}) },
+			kind: util.Disjoint,
+		},
+		{
+			name:  "Lines crossing in the past",
+			exec:  func() util.Intersection { return util.LineIntersection2I(pt{19, 13}, pt{-2, 1}, pt{20, 19}, pt{1, -5}) },
+			kind:  util.Crossing,
+			point: []string{"193/9", "106/9"},
+		},
+		{
+			name: "Parallel",
+			exec: func() util.Intersection {
+				return util.RayIntersection2I(pt{18, 19}, pt{-1, -1}, pt{20, 25}, pt{-2, -2})
+			},
+			kind: util.Parallel,
+		},
+		{
+			name: "Collinear rays towards each other",
+			exec: func() util.Intersection { return util.RayIntersection2I(pt{0, 0}, pt{1, 0}, pt{5, 0}, pt{-1, 0}) },
+			kind: util.Collinear,
+		},
+		{
+			name: "Collinear rays away from each other",
+			exec: func() util.Intersection { return util.RayIntersection2I(pt{0, 0}, pt{1, 0}, pt{-5, 0}, pt{-3, 0}) },
+			kind: util.Disjoint,
+		},
+		{
+			name:  "Segments crossing",
+			exec:  func() util.Intersection { return util.SegmentIntersection2I(pt{0, 0}, pt{4, 4}, pt{0, 4}, pt{4, 0}) },
+			kind:  util.Crossing,
+			point: []string{"2", "2"},
+		},
+		{
+			name: "Segments not reaching",
+			exec: func() util.Intersection { return util.SegmentIntersection2I(pt{0, 0}, pt{1, 1}, pt{0, 4}, pt{4, 0}) },
+			kind: util.Disjoint,
+		},
+		{
+			name: "Segments overlapping",
+			exec: func() util.Intersection { return util.SegmentIntersection2I(pt{0, 0}, pt{2, 0}, pt{5, 0}, pt{1, 0}) },
+			kind: util.Collinear,
+		},
+		{
+			name: "Segments collinear apart",
+			exec: func() util.Intersection { return util.SegmentIntersection2I(pt{0, 0}, pt{1, 0}, pt{2, 0}, pt{3, 0}) },
+			kind: util.Disjoint,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.exec()
+			checkIntersection(t, got, tt.kind, tt.point...)
+		})
+	}
+
+	// Directions of the segments overflow int8.
+	type pt8 = util.Point2I[int8]
+	checkIntersection(t, util.SegmentIntersection2I(pt8{-100, 0}, pt8{100, 0}, pt8{0, -100}, pt8{0, 100}), util.Crossing, "0", "0")
+}
+
+func TestIntersection3I(t *testing.T) {
+	type pt = util.Point3I[int]
+	got := util.LineIntersection3I(pt{0, 0, 0}, pt{1, 1, 1}, pt{2, 0, 0}, pt{0, 1, 1})
+	checkIntersection(t, got, util.Crossing, "2", "2", "2")
+	if got.T.Cmp(big.NewRat(2, 1)) != 0 || got.U.Cmp(big.NewRat(2, 1)) != 0 {
+		t.Errorf("T, U = %v, %v, want 2, 2", got.T, got.U)
+	}
+
+	checkIntersection(t, util.LineIntersection3I(pt{0, 0, 0}, pt{1, 0, 0}, pt{0, 1, 1}, pt{0, 1, 0}), util.Skew)
+	checkIntersection(t, util.LineIntersection3I(pt{0, 0, 0}, pt{1, 2, 3}, pt{1, 1, 1}, pt{2, 4, 6}), util.Parallel)
+	checkIntersection(t, util.RayIntersection3I(pt{0, 0, 0}, pt{1, 1, 1}, pt{2, 0, 0}, pt{0, -1, -1}), util.Disjoint)
+	checkIntersection(t, util.SegmentIntersection3I(pt{0, 0, 0}, pt{4, 4, 4}, pt{1, 1, 1}, pt{9, 9, 9}), util.Collinear)
+
+	type pt8 = util.Point3I[int8]
+	checkIntersection(t, util.SegmentIntersection3I(pt8{-100, 0, 0}, pt8{100, 0, 0}, pt8{0, -100, 0}, pt8{0, 100, 0}), util.Crossing, "0", "0", "0")
+}
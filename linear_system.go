@@ -0,0 +1,244 @@
+package util
+
+import (
+	"math/big"
+	"slices"
+)
+
+// SolutionKind classifies the solutions of a linear system.
+type SolutionKind int
+
+const (
+	// Inconsistent means the system has no solution.
+	Inconsistent SolutionKind = iota
+	// Unique means the system has exactly one solution.
+	Unique
+	// Underdetermined means the system has free variables, thus more than one solution.
+	Underdetermined
+)
+
+// LinearSolution describes all solutions of a linear system A x = b.
+//
+// Every solution has the form X + t_0*Basis[0] + t_1*Basis[1] + ..., where t_k is the value of
+// variable Free[k].
+type LinearSolution[E any] struct {
+	Kind SolutionKind
+	// X is a particular solution where all free variables are 0. It is nil if Kind is Inconsistent.
+	X []E
+	// Free lists the indices of free variables in ascending order.
+	Free []int
+	// Basis holds one vector for each free variable, spanning the null space of A.
+	Basis [][]E
+}
+
+// field abstracts the arithmetic needed by Gaussian elimination.
+type field[E any] struct {
+	zero, one E
+	sub, mul  func(E, E) E
+	inv       func(E) E
+	isZero    func(E) bool
+}
+
+// rref reduces the augmented matrix m to reduced row echelon form in place.
+//
+// It returns the pivot column of each non-zero row.
+func rref[E any](f field[E], m [][]E, cols int) []int {
+	var pivots []int
+	row := 0
+	for col := 0; col < cols && row < len(m); col++ {
+		p := slices.IndexFunc(m[row:], func(r []E) bool { return !f.isZero(r[col]) })
+		if p < 0 {
+			continue
+		}
+		m[row], m[row+p] = m[row+p], m[row]
+
+		inv := f.inv(m[row][col])
+		for j := range m[row] {
+			m[row][j] = f.mul(m[row][j], inv)
+		}
+		for i := range m {
+			if i == row || f.isZero(m[i][col]) {
+				continue
+			}
+			factor := m[i][col]
+			for j := range m[i] {
+				m[i][j] = f.sub(m[i][j], f.mul(factor, m[row][j]))
+			}
+		}
+		pivots = append(pivots, col)
+		row++
+	}
+	return pivots
+}
+
+// solve solves the augmented system [a | b] where a has n columns.
+func solve[E any](f field[E], m [][]E, n int) LinearSolution[E] {
+	pivots := rref(f, m, n)
+	for _, r := range m[len(pivots):] {
+		if !f.isZero(r[n]) {
+			return LinearSolution[E]{Kind: Inconsistent}
+		}
+	}
+
+	isPivot := make([]bool, n)
+	for _, c := range pivots {
+		isPivot[c] = true
+	}
+	ret := LinearSolution[E]{Kind: Unique, X: make([]E, n)}
+	for i := range ret.X {
+		ret.X[i] = f.zero
+	}
+	for r, c := range pivots {
+		ret.X[c] = m[r][n]
+	}
+	for col := 0; col < n; col++ {
+		if isPivot[col] {
+			continue
+		}
+		ret.Kind = Underdetermined
+		v := make([]E, n)
+		for i := range v {
+			v[i] = f.zero
+		}
+		v[col] = f.one
+		for r, c := range pivots {
+			v[c] = f.sub(f.zero, m[r][col])
+		}
+		ret.Free = append(ret.Free, col)
+		ret.Basis = append(ret.Basis, v)
+	}
+	return ret
+}
+
+func rationalField[T SignedInteger]() field[Rational[T]] {
+	return field[Rational[T]]{
+		zero:   Rational[T]{},
+		one:    RationalFromInt[T](1),
+		sub:    Rational[T].Sub,
+		mul:    Rational[T].Mul,
+		inv:    Rational[T].Inv,
+		isZero: func(r Rational[T]) bool { return r.Sign() == 0 },
+	}
+}
+
+func augment[T, E any](a [][]T, b []T, conv func(T) E) ([][]E, int) {
+	Assert(len(a) == len(b), "a and b have different number of rows")
+	n := 0
+	if len(a) > 0 {
+		n = len(a[0])
+	}
+	m := make([][]E, len(a))
+	for i, row := range a {
+		Assert(len(row) == n, "rows of a have different lengths")
+		m[i] = make([]E, n+1)
+		for j, v := range row {
+			m[i][j] = conv(v)
+		}
+		m[i][n] = conv(b[i])
+	}
+	return m, n
+}
+
+// SolveRational solves the linear system A x = b exactly over rationals, using Gaussian elimination.
+//
+// a is the coefficient matrix and must be rectangular, b must have one element per row of a.
+// Arithmetic panics with an error wrapping ErrOverflow if an intermediate value does not fit in T.
+func SolveRational[T SignedInteger](a [][]T, b []T) LinearSolution[Rational[T]] {
+	m, n := augment(a, b, RationalFromInt[T])
+	return solve(rationalField[T](), m, n)
+}
+
+// SolveMod solves the linear system A x = b modulo prime p, using Gaussian elimination.
+//
+// a is the coefficient matrix and must be rectangular, b must have one element per row of a.
+// Elements may be negative or not less than p, they are reduced first. All results are in range [0, p).
+func SolveMod[T Integer](a [][]T, b []T, p T) LinearSolution[T] {
+	m, n := augment(a, b, func(v T) T { return Mod(v, p) })
+	return solve(field[T]{
+		zero:   0,
+		one:    Mod(1, p),
+		sub:    func(x, y T) T { return addMod(x, Mod(p-y, p), p) },
+		mul:    func(x, y T) T { return mulMod(x, y, p) },
+		inv:    func(x T) T { return powMod(x, uint64(p-2), p) },
+		isZero: func(x T) bool { return x == 0 },
+	}, m, n)
+}
+
+// MinIntegerSolution finds the integer solution of A x = b with the least sum of variables,
+// where lo[i] <= x[i] <= hi[i] for each variable.
+//
+// Every free variable of the system is tried across its bounds, so the search is exponential in the
+// number of free variables. The second return value is false if no such solution exists.
+func MinIntegerSolution[T SignedInteger](a [][]T, b []T, lo, hi []T) ([]T, bool) {
+	sol := SolveRational(a, b)
+	if sol.Kind == Inconsistent {
+		return nil, false
+	}
+	n := len(sol.X)
+	Assert(len(lo) == n && len(hi) == n, "bounds have wrong length")
+
+	// Candidates are evaluated in big.Rat, since a free variable assignment may push other variables,
+	// or the sum, out of the range of T even if the minimum is within it.
+	toRat := func(r Rational[T]) *big.Rat { return big.NewRat(int64(r.Num()), int64(r.Den())) }
+	x0 := make([]*big.Rat, n)
+	for j, r := range sol.X {
+		x0[j] = toRat(r)
+	}
+	basis := make([][]*big.Rat, len(sol.Basis))
+	for i, v := range sol.Basis {
+		basis[i] = make([]*big.Rat, n)
+		for j, r := range v {
+			basis[i][j] = toRat(r)
+		}
+	}
+
+	var best []T
+	var bestSum, sum big.Int
+	var t big.Rat
+	x := make([]big.Rat, n)
+	cur := make([]T, n)
+
+	var search func(k int)
+	search = func(k int) {
+		if k < len(sol.Free) {
+			f := sol.Free[k]
+			if lo[f] > hi[f] {
+				return
+			}
+			for v := lo[f]; ; v++ {
+				cur[f] = v
+				search(k + 1)
+				if v == hi[f] {
+					return
+				}
+			}
+		}
+
+		sum.SetInt64(0)
+		for j := range x {
+			x[j].Set(x0[j])
+			for i, f := range sol.Free {
+				t.SetInt64(int64(cur[f]))
+				x[j].Add(&x[j], t.Mul(&t, basis[i][j]))
+			}
+			if !x[j].IsInt() {
+				return
+			}
+			v := x[j].Num()
+			if v.Cmp(big.NewInt(int64(lo[j]))) < 0 || v.Cmp(big.NewInt(int64(hi[j]))) > 0 {
+				return
+			}
+			sum.Add(&sum, v)
+		}
+		for j := range x {
+			cur[j] = T(x[j].Num().Int64())
+		}
+		if best == nil || sum.Cmp(&bestSum) < 0 {
+			best = slices.Clone(cur)
+			bestSum.Set(&sum)
+		}
+	}
+	search(0)
+
+	return best, best != nil
+}
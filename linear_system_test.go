@@ -0,0 +1,111 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestSolveRational(t *testing.T) {
+	r := util.NewRational[int64]
+	tests := []struct {
+		name string
+		a    [][]int64
+		b    []int64
+		want util.LinearSolution[util.Rational[int64]]
+	}{
+		{
+			name: "Unique integer",
+			a:    [][]int64{{2, 1}, {1, -1}},
+			b:    []int64{5, 1},
+			want: util.LinearSolution[util.Rational[int64]]{Kind: util.Unique, X: []util.Rational[int64]{r(2, 1), r(1, 1)}},
+		},
+		{
+			name: "Unique fraction",
+			a:    [][]int64{{1, 2}, {3, 4}, {2, 4}},
+			b:    []int64{1, 2, 2},
+			want: util.LinearSolution[util.Rational[int64]]{Kind: util.Unique, X: []util.Rational[int64]{r(0, 1), r(1, 2)}},
+		},
+		{
+			name: "Inconsistent",
+			a:    [][]int64{{1, 1}, {2, 2}},
+			b:    []int64{1, 3},
+			want: util.LinearSolution[util.Rational[int64]]{Kind: util.Inconsistent},
+		},
+		{
+			name: "Underdetermined",
+			a:    [][]int64{{1, 1, 1}},
+			b:    []int64{3},
+			want: util.LinearSolution[util.Rational[int64]]{
+				Kind: util.Underdetermined,
+				X:    []util.Rational[int64]{r(3, 1), r(0, 1), r(0, 1)},
+				Free: []int{1, 2},
+				Basis: [][]util.Rational[int64]{
+					{r(-1, 1), r(1, 1), r(0, 1)},
+					{r(-1, 1), r(0, 1), r(1, 1)},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.SolveRational(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SolveRational() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSolveMod(t *testing.T) {
+	got := util.SolveMod([][]int{{2, 3}, {1, -1}}, []int{3, 4}, 7)
+	want := util.LinearSolution[int]{Kind: util.Unique, X: []int{3, 6}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SolveMod() = %v, want %v", got, want)
+	}
+
+	if got := util.SolveMod([][]uint64{{2, 4}}, []uint64{1}, 2); got.Kind != util.Inconsistent {
+		t.Errorf("SolveMod() kind = %v, want %v", got.Kind, util.Inconsistent)
+	}
+}
+
+func TestMinIntegerSolution(t *testing.T) {
+	a := [][]int{
+		{0, 0, 0, 0, 1, 1},
+		{0, 1, 0, 0, 0, 1},
+		{0, 0, 1, 1, 1, 0},
+		{1, 1, 0, 1, 0, 0},
+	}
+	b := []int{3, 5, 4, 7}
+	lo := []int{0, 0, 0, 0, 0, 0}
+	hi := []int{7, 7, 7, 7, 7, 7}
+
+	got, ok := util.MinIntegerSolution(a, b, lo, hi)
+	if !ok {
+		t.Fatalf("MinIntegerSolution() found no solution")
+	}
+	if sum := util.Reduce(got, func(s, v int) int { return s + v }, 0); sum != 10 {
+		t.Errorf("MinIntegerSolution() = %v with sum %d, want sum 10", got, sum)
+	}
+	for i, row := range a {
+		if v := util.ReduceIndex(row, func(s, j, c int) int { return s + c*got[j] }, 0); v != b[i] {
+			t.Errorf("MinIntegerSolution() = %v does not satisfy row %d", got, i)
+		}
+	}
+
+	if _, ok := util.MinIntegerSolution([][]int{{2, 2}}, []int{3}, []int{0, 0}, []int{5, 5}); ok {
+		t.Errorf("MinIntegerSolution() found solution for 2x + 2y = 3")
+	}
+
+	// The free variable ranges up to the maximum of int8.
+	got8, ok := util.MinIntegerSolution([][]int8{{1, 1}}, []int8{0}, []int8{-127, 100}, []int8{127, 127})
+	if !ok || got8[0]+got8[1] != 0 || got8[1] < 100 {
+		t.Errorf("MinIntegerSolution() = %v, %v, want solution of x + y = 0", got8, ok)
+	}
+
+	// Some assignments of the free variables push x out of the range of int8.
+	got8, ok = util.MinIntegerSolution([][]int8{{1, 1, 1}}, []int8{100}, []int8{0, 0, 0}, []int8{127, 127, 127})
+	if want := []int8{100, 0, 0}; !ok || !reflect.DeepEqual(got8, want) {
+		t.Errorf("MinIntegerSolution() = %v, %v, want %v", got8, ok, want)
+	}
+}
@@ -2,8 +2,10 @@ package util
 
 import (
 	"fmt"
+	"math/bits"
 	"slices"
 	"strings"
+	"unsafe"
 )
 
 type Integer interface {
@@ -15,6 +17,19 @@ type SignedInteger interface {
 	~int | ~int8 | ~int16 | ~int32 | ~int64
 }
 
+type Float interface {
+	~float32 | ~float64
+}
+
+type Number interface {
+	Integer | Float
+}
+
+// bitSize returns the number of bits in number type T.
+func bitSize[T Number]() int {
+	return int(unsafe.Sizeof(T(0))) * 8
+}
+
 // Abs returns the absolute value of integer n.
 func Abs[T Integer](n T) T {
 	if n < 0 {
@@ -66,6 +81,216 @@ func LCM[T Integer](a, b T, more ...T) T {
 	return l
 }
 
+// Mod returns the Euclidean modulo of n by m, which is always in range [0, |m|).
+//
+// Unlike the % operator, the result is never negative, e.g. Mod(-1, 5) == 4.
+func Mod[T Integer](n, m T) T {
+	n %= m
+	if n < 0 {
+		if m < 0 {
+			n -= m
+		} else {
+			n += m
+		}
+	}
+	return n
+}
+
+// FloorDiv returns n/m rounded toward negative infinity.
+//
+// Unlike the / operator, the result is never rounded up, e.g. FloorDiv(-1, 5) == -1.
+// For positive m, n == FloorDiv(n, m)*m + Mod(n, m).
+func FloorDiv[T Integer](n, m T) T {
+	q := n / m
+	if n%m != 0 && (n < 0) != (m < 0) {
+		q--
+	}
+	return q
+}
+
+// addMod returns a+b modulo m, for a and b in range [0, m). It never overflows.
+func addMod[T Integer](a, b, m T) T {
+	if a >= m-b {
+		return a - (m - b)
+	}
+	return a + b
+}
+
+// mulMod returns a*b modulo m, for a and b in range [0, m). It never overflows.
+func mulMod[T Integer](a, b, m T) T {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	return T(bits.Rem64(hi, lo, uint64(m)))
+}
+
+// powMod returns a^e modulo m, for a in range [0, m).
+func powMod[T Integer](a T, e uint64, m T) T {
+	r := Mod(1, m)
+	for ; e > 0; e >>= 1 {
+		if e&1 == 1 {
+			r = mulMod(r, a, m)
+		}
+		a = mulMod(a, a, m)
+	}
+	return r
+}
+
+// Matrix[T] is a dense integer matrix, indexed by row then column.
+type Matrix[T Integer] [][]T
+
+// NewMatrix creates a zero matrix of given size.
+func NewMatrix[T Integer](rows, cols int) Matrix[T] {
+	m := make(Matrix[T], rows)
+	for i := range m {
+		m[i] = make([]T, cols)
+	}
+	return m
+}
+
+// IdentityMatrix creates an n*n identity matrix.
+func IdentityMatrix[T Integer](n int) Matrix[T] {
+	m := NewMatrix[T](n, n)
+	for i := range m {
+		m[i][i] = 1
+	}
+	return m
+}
+
+// CompanionMatrix creates the companion matrix of linear recurrence
+// a[n] = coeffs[0]*a[n-1] + coeffs[1]*a[n-2] + ... + coeffs[k-1]*a[n-k].
+//
+// It maps state vector (a[n-1], a[n-2], ..., a[n-k]) to (a[n], a[n-1], ..., a[n-k+1]).
+// If coeffs is empty, this function panics.
+func CompanionMatrix[T Integer](coeffs []T) Matrix[T] {
+	Assert(len(coeffs) > 0, "empty coeffs")
+	m := NewMatrix[T](len(coeffs), len(coeffs))
+	copy(m[0], coeffs)
+	for i := 1; i < len(m); i++ {
+		m[i][i-1] = 1
+	}
+	return m
+}
+
+// Rows returns the number of rows.
+func (m Matrix[T]) Rows() int {
+	return len(m)
+}
+
+// Cols returns the number of columns.
+func (m Matrix[T]) Cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+func (m Matrix[T]) mul(o Matrix[T], mulAdd func(acc, a, b T) T) Matrix[T] {
+	Assert(m.Cols() == o.Rows(), "matrix dimensions mismatch")
+	r := NewMatrix[T](m.Rows(), o.Cols())
+	for i := range m {
+		for k, a := range m[i] {
+			if a == 0 {
+				continue
+			}
+			for j, b := range o[k] {
+				r[i][j] = mulAdd(r[i][j], a, b)
+			}
+		}
+	}
+	return r
+}
+
+func (m Matrix[T]) pow(n uint64, mul func(l, r Matrix[T]) Matrix[T]) Matrix[T] {
+	Assert(m.Rows() == m.Cols(), "matrix is not square")
+	r := IdentityMatrix[T](m.Rows())
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r = mul(r, m)
+		}
+		m = mul(m, m)
+	}
+	return r
+}
+
+// Mul returns the matrix product m*o. If dimensions mismatch, this function panics.
+func (m Matrix[T]) Mul(o Matrix[T]) Matrix[T] {
+	return m.mul(o, func(acc, a, b T) T { return acc + a*b })
+}
+
+// MulVec returns the product of m and column vector v.
+func (m Matrix[T]) MulVec(v []T) []T {
+	Assert(m.Cols() == len(v), "matrix dimensions mismatch")
+	r := make([]T, m.Rows())
+	for i := range m {
+		for j, a := range m[i] {
+			r[i] += a * v[j]
+		}
+	}
+	return r
+}
+
+// Pow returns m to the n-th power, using exponentiation by squaring. If m is not square, this function panics.
+func (m Matrix[T]) Pow(n uint64) Matrix[T] {
+	return m.pow(n, Matrix[T].Mul)
+}
+
+// Mod returns a copy of m with every element reduced to range [0, mod).
+func (m Matrix[T]) Mod(mod T) Matrix[T] {
+	r := NewMatrix[T](m.Rows(), m.Cols())
+	for i := range m {
+		for j, a := range m[i] {
+			r[i][j] = Mod(a, mod)
+		}
+	}
+	return r
+}
+
+// MulMod returns the matrix product m*o modulo mod. Elements of m and o must be in range [0, mod).
+//
+// Intermediate products never overflow.
+func (m Matrix[T]) MulMod(o Matrix[T], mod T) Matrix[T] {
+	return m.mul(o, func(acc, a, b T) T { return addMod(acc, mulMod(a, b, mod), mod) })
+}
+
+// PowMod returns m to the n-th power modulo mod. Elements of m must be in range [0, mod).
+func (m Matrix[T]) PowMod(n uint64, mod T) Matrix[T] {
+	return m.Mod(mod).pow(n, func(l, r Matrix[T]) Matrix[T] { return l.MulMod(r, mod) }).Mod(mod)
+}
+
+// LinearRecurrence returns a[n] of linear recurrence
+// a[n] = coeffs[0]*a[n-1] + coeffs[1]*a[n-2] + ... + coeffs[k-1]*a[n-k],
+// whose first terms are a[0], a[1], ..., a[k-1] = init[0], init[1], ..., init[k-1].
+//
+// coeffs must not be empty and must have the same length as init, otherwise this function panics.
+func LinearRecurrence[T Integer](coeffs, init []T, n uint64) T {
+	Assert(len(coeffs) > 0, "empty coeffs")
+	Assert(len(coeffs) == len(init), "coeffs and init have different lengths")
+	k := uint64(len(init))
+	if n < k {
+		return init[n]
+	}
+	state := slices.Clone(init)
+	slices.Reverse(state)
+	return CompanionMatrix(coeffs).Pow(n - k + 1).MulVec(state)[0]
+}
+
+// LinearRecurrenceMod is like LinearRecurrence, but computes a[n] modulo mod.
+func LinearRecurrenceMod[T Integer](coeffs, init []T, n uint64, mod T) T {
+	Assert(len(coeffs) > 0, "empty coeffs")
+	Assert(len(coeffs) == len(init), "coeffs and init have different lengths")
+	k := uint64(len(init))
+	if n < k {
+		return Mod(init[n], mod)
+	}
+	state := Matrix[T]{slices.Clone(init)}.Mod(mod)[0]
+	slices.Reverse(state)
+	p := CompanionMatrix(coeffs).PowMod(n-k+1, mod)
+	var r T
+	for j, a := range p[0] {
+		r = addMod(r, mulMod(a, state[j], mod), mod)
+	}
+	return r
+}
+
 // ToBalancedQuinary converts integer n to a balanced quinary string.
 //
 // In returned string, digit -2 is represented by '=', digit -1 by '-', digit 0, 1, 2 by '0', '1', '2' respectively.
@@ -205,6 +430,16 @@ func (p Point2I[T]) Neg() Point2I[T] {
 	return Point2I[T]{-p.X, -p.Y}
 }
 
+// Mod returns p with each component reduced by Mod to range [0, |m.X|) and [0, |m.Y|) respectively.
+func (p Point2I[T]) Mod(m Point2I[T]) Point2I[T] {
+	return Point2I[T]{Mod(p.X, m.X), Mod(p.Y, m.Y)}
+}
+
+// FloorDiv returns p with each component divided by FloorDiv.
+func (p Point2I[T]) FloorDiv(m Point2I[T]) Point2I[T] {
+	return Point2I[T]{FloorDiv(p.X, m.X), FloorDiv(p.Y, m.Y)}
+}
+
 // Less returns true if p is lexicographically less than q.
 func (p Point2I[T]) Less(rhs Point2I[T]) bool {
 	if p.X == rhs.X {
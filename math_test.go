@@ -1,7 +1,9 @@
 package util_test
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	util "github.com/Xiangze-Li/golang-util"
@@ -126,3 +128,96 @@ func TestNegateBalancedQuinary(t *testing.T) {
 		})
 	}
 }
+
+func TestMatrix(t *testing.T) {
+	a := util.Matrix[int]{{1, 2}, {3, 4}}
+	b := util.Matrix[int]{{0, 1}, {1, 0}}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{name: "Mul", got: a.Mul(b), want: util.Matrix[int]{{2, 1}, {4, 3}}},
+		{name: "Mul non-square", got: util.Matrix[int]{{1, 2, 3}}.Mul(util.Matrix[int]{{1}, {2}, {3}}), want: util.Matrix[int]{{14}}},
+		{name: "MulVec", got: a.MulVec([]int{1, 1}), want: []int{3, 7}},
+		{name: "Pow 0", got: a.Pow(0), want: util.IdentityMatrix[int](2)},
+		{name: "Pow 3", got: a.Pow(3), want: util.Matrix[int]{{37, 54}, {81, 118}}},
+		{name: "PowMod", got: a.PowMod(3, 10), want: util.Matrix[int]{{7, 4}, {1, 8}}},
+		{name: "Mod", got: util.Matrix[int]{{-1, 12}}.Mod(5), want: util.Matrix[int]{{4, 2}}},
+		{name: "Companion", got: util.CompanionMatrix([]int{1, 2, 3}), want: util.Matrix[int]{{1, 2, 3}, {1, 0, 0}, {0, 1, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinearRecurrence(t *testing.T) {
+	fib := []int64{0, 1}
+	for i := 2; i <= 90; i++ {
+		fib = append(fib, fib[i-1]+fib[i-2])
+	}
+
+	for _, n := range []uint64{0, 1, 2, 10, 90} {
+		if got := util.LinearRecurrence([]int64{1, 1}, []int64{0, 1}, n); got != fib[n] {
+			t.Errorf("LinearRecurrence(fib, %d) = %d, want %d", n, got, fib[n])
+		}
+		if got := util.LinearRecurrenceMod([]int64{1, 1}, []int64{0, 1}, n, 1_000_000_007); got != fib[n]%1_000_000_007 {
+			t.Errorf("LinearRecurrenceMod(fib, %d) = %d, want %d", n, got, fib[n]%1_000_000_007)
+		}
+	}
+
+	const bigPrime = 18446744073709551557 // largest prime below 2^64
+	if got := util.LinearRecurrenceMod([]uint64{1, 1}, []uint64{0, 1}, 90, bigPrime); got != uint64(fib[90]) {
+		t.Errorf("LinearRecurrenceMod(fib, 90) with large modulus = %d, want %d", got, fib[90])
+	}
+	if got := util.LinearRecurrence([]int{2, -1}, []int{3, 5}, 100); got != 203 {
+		t.Errorf("LinearRecurrence(arithmetic) = %d, want 203", got)
+	}
+
+	err := func() (err error) {
+		defer util.Recover(&err)
+		util.LinearRecurrence([]int{}, []int{}, 0)
+		return nil
+	}()
+	if !errors.Is(err, util.ErrAssertion) {
+		t.Errorf("LinearRecurrence(empty) error = %v, want %v", err, util.ErrAssertion)
+	}
+}
+
+func TestModFloorDiv(t *testing.T) {
+	tests := []struct {
+		n, m, mod, div int
+	}{
+		{n: 7, m: 3, mod: 1, div: 2},
+		{n: -7, m: 3, mod: 2, div: -3},
+		{n: -6, m: 3, mod: 0, div: -2},
+		{n: 7, m: -3, mod: 1, div: -3},
+		{n: -7, m: -3, mod: 2, div: 2},
+		{n: 0, m: 5, mod: 0, div: 0},
+	}
+	for idx, tt := range tests {
+		t.Run(fmt.Sprint("case ", idx), func(t *testing.T) {
+			if got := util.Mod(tt.n, tt.m); got != tt.mod {
+				t.Errorf("Mod(%d, %d) = %d, want %d", tt.n, tt.m, got, tt.mod)
+			}
+			if got := util.FloorDiv(tt.n, tt.m); got != tt.div {
+				t.Errorf("FloorDiv(%d, %d) = %d, want %d", tt.n, tt.m, got, tt.div)
+			}
+		})
+	}
+	if got := util.Mod[uint8](250, 7); got != 5 {
+		t.Errorf("Mod[uint8](250, 7) = %d, want 5", got)
+	}
+	p := util.Point2I[int]{-1, 12}
+	if got := p.Mod(util.Point2I[int]{5, 5}); got != (util.Point2I[int]{4, 2}) {
+		t.Errorf("Point2I.Mod() = %v, want (4, 2)", got)
+	}
+	if got := p.FloorDiv(util.Point2I[int]{5, 5}); got != (util.Point2I[int]{-1, 2}) {
+		t.Errorf("Point2I.FloorDiv() = %v, want (-1, 2)", got)
+	}
+}
@@ -1,5 +1,14 @@
 package util
 
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+// ErrAssertion is wrapped by panic values of Assertf, and by errors converted by Recover from Assert panics.
+var ErrAssertion = errors.New("assertion failed")
+
 // Must is used to handle errors neatly.
 //
 // If err is not nil, this function panics with the error. Otherwise, it returns
@@ -11,9 +20,72 @@ func Must[T any](val T, err error) T {
 	return val
 }
 
+// MustF is like Must, but adds context to the error.
+//
+// If err is not nil, this function panics with an error whose message is the formatted context
+// followed by the message of err. The panic value wraps err, so errors.Is and errors.As still work.
+func MustF[T any](val T, err error, format string, args ...any) T {
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err))
+	}
+	return val
+}
+
+// Must2 is like Must, for functions returning two values and an error.
+func Must2[T1, T2 any](v1 T1, v2 T2, err error) (T1, T2) {
+	if err != nil {
+		panic(err)
+	}
+	return v1, v2
+}
+
+// Must3 is like Must, for functions returning three values and an error.
+func Must3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
+	if err != nil {
+		panic(err)
+	}
+	return v1, v2, v3
+}
+
 // Assert panics with the given message if cond is false.
 func Assert(cond bool, msg string) {
 	if !cond {
 		panic(msg)
 	}
 }
+
+// Assertf panics with a formatted message if cond is false.
+//
+// The panic value is an error wrapping ErrAssertion.
+func Assertf(cond bool, format string, args ...any) {
+	if !cond {
+		panic(fmt.Errorf("%w: %s", ErrAssertion, fmt.Sprintf(format, args...)))
+	}
+}
+
+// Recover converts panics of Must and Assert families back into errors at an API boundary.
+//
+// It must be deferred directly, with a pointer to the named error result of the function:
+//
+//	func Parse(s string) (result T, err error) {
+//		defer util.Recover(&err)
+//		...
+//	}
+//
+// Error panic values are stored as is. String panic values, as raised by Assert, are converted to
+// errors wrapping ErrAssertion. Runtime errors, such as index out of range, and other panic values
+// are re-panicked.
+func Recover(errp *error) {
+	switch r := recover().(type) {
+	case nil:
+	case error:
+		if errors.As(r, new(runtime.Error)) {
+			panic(r)
+		}
+		*errp = r
+	case string:
+		*errp = fmt.Errorf("%w: %s", ErrAssertion, r)
+	default:
+		panic(r)
+	}
+}
@@ -2,6 +2,7 @@ package util_test
 
 import (
 	"errors"
+	"runtime"
 	"testing"
 
 	util "github.com/Xiangze-Li/golang-util"
@@ -75,3 +76,113 @@ func TestAssert(t *testing.T) {
 		})
 	}
 }
+
+var errBase = errors.New("base error")
+
+func TestMustF(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, errBase) || err.Error() != "parsing line 3: base error" {
+			t.Errorf("MustF() panicked with %v, want wrapped %v", err, errBase)
+		}
+	}()
+	if got := util.MustF(42, nil, "unused"); got != 42 {
+		t.Errorf("MustF() = %v, want 42", got)
+	}
+	util.MustF(0, errBase, "parsing line %d", 3)
+}
+
+func TestMustN(t *testing.T) {
+	a, b := util.Must2(1, "a", nil)
+	x, y, z := util.Must3(1, "a", true, nil)
+	if a != 1 || b != "a" || x != 1 || y != "a" || !z {
+		t.Errorf("Must2() or Must3() returns wrong values")
+	}
+
+	defer func() {
+		if r := recover(); r != errBase { //nolint:errorlint // shallow comparison is intended
+			t.Errorf("Must3() panicked with %v, want %v", r, errBase)
+		}
+	}()
+	util.Must3(1, 2, 3, errBase)
+}
+
+func TestAssertf(t *testing.T) {
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, util.ErrAssertion) || err.Error() != "assertion failed: want 1, got 2" {
+			t.Errorf("Assertf() panicked with %v", err)
+		}
+	}()
+	util.Assertf(true, "unused")
+	util.Assertf(false, "want %d, got %d", 1, 2)
+}
+
+func TestRecover(t *testing.T) {
+	run := func(f func()) (err error) {
+		defer util.Recover(&err)
+		f()
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		exec    func()
+		wantErr error
+		wantMsg string
+	}{
+		{name: "No panic", exec: func() {}},
+		{
+			name:    "Must",
+			exec:    func() { util.Must(0, errBase) },
+			wantErr: errBase,
+			wantMsg: "base error",
+		},
+		{
+			name:    "MustF",
+			exec:    func() { util.MustF(0, errBase, "context") },
+			wantErr: errBase,
+			wantMsg: "context: base error",
+		},
+		{
+			name:    "Assert",
+			exec:    func() { util.Assert(false, "bad input") },
+			wantErr: util.ErrAssertion,
+			wantMsg: "assertion failed: bad input",
+		},
+		{
+			name:    "Assertf",
+			exec:    func() { util.Assertf(false, "bad %s", "input") },
+			wantErr: util.ErrAssertion,
+			wantMsg: "assertion failed: bad input",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := run(tt.exec)
+			if !errors.Is(err, tt.wantErr) || (err != nil && err.Error() != tt.wantMsg) {
+				t.Errorf("Recover() gives error %v, want %v", err, tt.wantMsg)
+			}
+		})
+	}
+
+	t.Run("Other panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r != 42 {
+				t.Errorf("Recover() re-panicked with %v, want 42", r)
+			}
+		}()
+		_ = run(func() { panic(42) })
+	})
+
+	t.Run("Runtime error", func(t *testing.T) {
+		defer func() {
+			if _, ok := recover().(runtime.Error); !ok {
+				t.Errorf("Recover() did not re-panic runtime error")
+			}
+		}()
+		s := []int{}
+		i := 1
+		_ = run(func() { _ = s[i] })
+	})
+}
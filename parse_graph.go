@@ -0,0 +1,70 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEmptyNode is wrapped by errors of adjacency lines holding an empty node name.
+var ErrEmptyNode = errors.New("empty node name")
+
+// EdgeSpec describes the layout of adjacency lines, such as "AA = (BB, CC)", "a -> b, c" or "x: y z".
+type EdgeSpec struct {
+	// KeySep separates the source node from its targets, e.g. "=", "->" or ":".
+	KeySep string
+	// ListSep separates targets, e.g. ",". If empty, targets are separated by whitespace.
+	ListSep string
+	// Trim holds characters removed from both ends of the target list, e.g. "()".
+	Trim string
+}
+
+// ParseLine splits an adjacency line into its source node and targets.
+//
+// It returns an error wrapping ErrMissingSeparator if the line lacks spec.KeySep, or ErrEmptyNode if a
+// node name is empty.
+func (spec EdgeSpec) ParseLine(line string) (string, []string, error) {
+	key, list, ok := strings.Cut(line, spec.KeySep)
+	if !ok {
+		return "", nil, fmt.Errorf("%w %q", ErrMissingSeparator, spec.KeySep)
+	}
+	if key = strings.TrimSpace(key); key == "" {
+		return "", nil, ErrEmptyNode
+	}
+
+	list = strings.Trim(strings.TrimSpace(list), spec.Trim)
+	var targets []string
+	if spec.ListSep == "" {
+		targets = strings.Fields(list)
+	} else if list = strings.TrimSpace(list); list != "" {
+		targets = strings.Split(list, spec.ListSep)
+		for i := range targets {
+			if targets[i] = strings.TrimSpace(targets[i]); targets[i] == "" {
+				return "", nil, ErrEmptyNode
+			}
+		}
+	}
+	return key, targets, nil
+}
+
+// ParseAdjacency parses adjacency lines, as read by GetLines, into a map from each source node to its targets.
+//
+// Blank lines are skipped. Targets of lines sharing the same source node are concatenated. A line may
+// have no target, e.g. "x:", in which case the source node still appears in the map.
+//
+// If a line is malformed, a *ParseError is returned. It wraps ErrMissingSeparator if the line lacks
+// spec.KeySep, or ErrEmptyNode if a node name is empty.
+func ParseAdjacency(lines []string, spec EdgeSpec) (map[string][]string, error) {
+	adj := make(map[string][]string)
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		key, targets, err := spec.ParseLine(line)
+		if err != nil {
+			return nil, &ParseError{Index: i, Str: line, Err: err}
+		}
+		adj[key] = append(adj[key], targets...)
+	}
+	return adj, nil
+}
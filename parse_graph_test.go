@@ -0,0 +1,74 @@
+package util_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestParseAdjacency(t *testing.T) {
+	tests := []struct {
+		name    string
+		lines   []string
+		spec    util.EdgeSpec
+		want    map[string][]string
+		wantErr error
+	}{
+		{
+			name:  "Tuple",
+			lines: []string{"AAA = (BBB, CCC)", "BBB = (DDD, EEE)"},
+			spec:  util.EdgeSpec{KeySep: "=", ListSep: ",", Trim: "()"},
+			want:  map[string][]string{"AAA": {"BBB", "CCC"}, "BBB": {"DDD", "EEE"}},
+		},
+		{
+			name:  "Arrow",
+			lines: []string{"broadcaster -> a, b", "", "a -> b", "a -> c"},
+			spec:  util.EdgeSpec{KeySep: "->", ListSep: ","},
+			want:  map[string][]string{"broadcaster": {"a", "b"}, "a": {"b", "c"}},
+		},
+		{
+			name:  "Whitespace list",
+			lines: []string{"jqt: rhn xhk  nvd", "rsh:"},
+			spec:  util.EdgeSpec{KeySep: ":"},
+			want:  map[string][]string{"jqt": {"rhn", "xhk", "nvd"}, "rsh": nil},
+		},
+		{
+			name:    "Missing separator",
+			lines:   []string{"a: b", "c d"},
+			spec:    util.EdgeSpec{KeySep: ":"},
+			wantErr: util.ErrMissingSeparator,
+		},
+		{
+			name:    "Empty target",
+			lines:   []string{"a -> b,,c"},
+			spec:    util.EdgeSpec{KeySep: "->", ListSep: ","},
+			wantErr: util.ErrEmptyNode,
+		},
+		{
+			name:    "Empty source",
+			lines:   []string{" : b"},
+			spec:    util.EdgeSpec{KeySep: ":"},
+			wantErr: util.ErrEmptyNode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.ParseAdjacency(tt.lines, tt.spec)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseAdjacency() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				var parseErr *util.ParseError
+				if !errors.As(err, &parseErr) || parseErr.Str != tt.lines[parseErr.Index] {
+					t.Errorf("ParseAdjacency() error = %v, want *ParseError", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAdjacency() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"fmt"
+
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+// ShoelaceArea2 returns twice the signed area of the polygon with vertices pts, using the shoelace formula.
+//
+// The polygon is closed implicitly, repeating the first vertex at the end is allowed. The result
+// is exact, as the area of a lattice polygon is always a multiple of 1/2.
+func ShoelaceArea2[T SignedInteger](pts []Point2I[T]) T {
+	var sum T
+	for i, p := range pts {
+		q := pts[(i+1)%len(pts)]
+		sum += p.X*q.Y - q.X*p.Y
+	}
+	return sum
+}
+
+// ShoelaceArea returns the area of the polygon with vertices pts, rounded down.
+//
+// Use ShoelaceArea2 if the exact value is needed.
+func ShoelaceArea[T SignedInteger](pts []Point2I[T]) T {
+	return Abs(ShoelaceArea2(pts)) / 2
+}
+
+// Perimeter returns the Manhattan length of the closed path through pts.
+//
+// For polygons with axis-aligned edges, it is the perimeter.
+func Perimeter[T SignedInteger](pts []Point2I[T]) T {
+	var sum T
+	for i, p := range pts {
+		d := pts[(i+1)%len(pts)].Sub(p)
+		sum += Abs(d.X) + Abs(d.Y)
+	}
+	return sum
+}
+
+// BoundaryPoints returns the number of lattice points on the boundary of the polygon with vertices pts.
+func BoundaryPoints[T SignedInteger](pts []Point2I[T]) T {
+	var sum T
+	for i, p := range pts {
+		d := pts[(i+1)%len(pts)].Sub(p)
+		sum += GCD(Abs(d.X), Abs(d.Y))
+	}
+	return sum
+}
+
+// InteriorPoints returns the number of lattice points strictly inside the polygon with vertices pts,
+// using Pick's theorem.
+//
+// The polygon must be simple, i.e. its edges do not cross each other.
+func InteriorPoints[T SignedInteger](pts []Point2I[T]) T {
+	return (Abs(ShoelaceArea2(pts)) - BoundaryPoints(pts) + 2) / 2
+}
+
+// VerticesFromDirections walks from start, moving lengths[i] steps in direction dirs[i] each time,
+// and returns all visited vertices, start included.
+//
+// Directions are looked up in c.Delta8. If dirs and lengths have different lengths, this function panics.
+func VerticesFromDirections[T SignedInteger](start Point2I[T], dirs []c.Direction, lengths []T) []Point2I[T] {
+	Assert(len(dirs) == len(lengths), "dirs and lengths have different lengths")
+	pts := make([]Point2I[T], 0, len(dirs)+1)
+	pts = append(pts, start)
+	for i, d := range dirs {
+		delta, ok := c.Delta8[d]
+		Assert(ok, "invalid direction")
+		start = start.Add(Point2I[T]{T(delta[0]), T(delta[1])}.Mul(lengths[i]))
+		pts = append(pts, start)
+	}
+	return pts
+}
+
+// VerticesFromUDLR is like VerticesFromDirections, with directions given as 'U', 'D', 'L' and 'R'.
+//
+// If other characters are present, an error is returned.
+func VerticesFromUDLR[T SignedInteger](start Point2I[T], dirs []byte, lengths []T) ([]Point2I[T], error) {
+	ds := make([]c.Direction, len(dirs))
+	for i, b := range dirs {
+		d, ok := c.ConvertFromUDLR[b]
+		if !ok {
+			return nil, fmt.Errorf("invalid direction %c at index %d", b, i)
+		}
+		ds[i] = d
+	}
+	return VerticesFromDirections(start, ds, lengths), nil
+}
@@ -0,0 +1,88 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+func TestPolygon(t *testing.T) {
+	type pt = util.Point2I[int64]
+	tests := []struct {
+		name     string
+		pts      []pt
+		area2    int64
+		boundary int64
+		interior int64
+		perim    int64
+	}{
+		{
+			name:     "Unit square",
+			pts:      []pt{{0, 0}, {0, 1}, {1, 1}, {1, 0}},
+			area2:    -2,
+			boundary: 4,
+			interior: 0,
+			perim:    4,
+		},
+		{
+			name:     "Rectangle closed explicitly",
+			pts:      []pt{{0, 0}, {3, 0}, {3, 4}, {0, 4}, {0, 0}},
+			area2:    24,
+			boundary: 14,
+			interior: 6,
+			perim:    14,
+		},
+		{
+			name:     "Triangle",
+			pts:      []pt{{0, 0}, {4, 0}, {0, 3}},
+			area2:    12,
+			boundary: 8,
+			interior: 3,
+			perim:    14,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := util.ShoelaceArea2(tt.pts); got != tt.area2 {
+				t.Errorf("ShoelaceArea2() = %d, want %d", got, tt.area2)
+			}
+			if got := util.ShoelaceArea(tt.pts); got != util.Abs(tt.area2)/2 {
+				t.Errorf("ShoelaceArea() = %d, want %d", got, util.Abs(tt.area2)/2)
+			}
+			if got := util.BoundaryPoints(tt.pts); got != tt.boundary {
+				t.Errorf("BoundaryPoints() = %d, want %d", got, tt.boundary)
+			}
+			if got := util.InteriorPoints(tt.pts); got != tt.interior {
+				t.Errorf("InteriorPoints() = %d, want %d", got, tt.interior)
+			}
+			if got := util.Perimeter(tt.pts); got != tt.perim {
+				t.Errorf("Perimeter() = %d, want %d", got, tt.perim)
+			}
+		})
+	}
+}
+
+func TestVerticesFromUDLR(t *testing.T) {
+	pts, err := util.VerticesFromUDLR(util.Point2I[int]{},
+		[]byte("RDLDRDLULURULU"), []int{6, 5, 2, 2, 2, 2, 5, 2, 1, 2, 2, 3, 2, 2})
+	if err != nil {
+		t.Fatalf("VerticesFromUDLR() error = %v", err)
+	}
+	if pts[len(pts)-1] != pts[0] {
+		t.Errorf("VerticesFromUDLR() path not closed, ends at %v", pts[len(pts)-1])
+	}
+	if got := util.InteriorPoints(pts) + util.BoundaryPoints(pts); got != 62 {
+		t.Errorf("lagoon size = %d, want 62", got)
+	}
+
+	if _, err := util.VerticesFromUDLR(util.Point2I[int]{}, []byte("UX"), []int{1, 1}); err == nil {
+		t.Errorf("VerticesFromUDLR() error = nil, want error")
+	}
+
+	got := util.VerticesFromDirections(util.Point2I[int]{X: 1, Y: 1}, []c.Direction{c.SE, c.N}, []int{2, 3})
+	if want := []util.Point2I[int]{{1, 1}, {3, 3}, {0, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("VerticesFromDirections() = %v, want %v", got, want)
+	}
+}
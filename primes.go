@@ -0,0 +1,179 @@
+package util
+
+import (
+	"slices"
+)
+
+// Sieve returns a slice ret of length n+1, where ret[i] is true if and only if i is prime.
+//
+// It uses the sieve of Eratosthenes.
+func Sieve(n int) []bool {
+	if n < 0 {
+		return nil
+	}
+	ret := make([]bool, n+1)
+	for i := 2; i <= n; i++ {
+		ret[i] = true
+	}
+	for i := 2; i*i <= n; i++ {
+		if ret[i] {
+			for j := i * i; j <= n; j += i {
+				ret[j] = false
+			}
+		}
+	}
+	return ret
+}
+
+// PrimesUpTo returns all primes not greater than n in ascending order.
+func PrimesUpTo(n int) []int {
+	var ret []int
+	for i, ok := range Sieve(n) {
+		if ok {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
+
+// IsPrime reports whether n is prime, using the Miller-Rabin test.
+//
+// The test is deterministic for all 64-bit integers.
+func IsPrime[T Integer](n T) bool {
+	if n < 2 {
+		return false
+	}
+	return isPrime64(uint64(n))
+}
+
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+func isPrime64(n uint64) bool {
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	if n < 37*37 {
+		return n > 1
+	}
+
+	d, s := n-1, 0
+	for d%2 == 0 {
+		d, s = d/2, s+1
+	}
+	for _, a := range millerRabinBases {
+		x := powMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for r := 1; r < s && composite; r++ {
+			x = mulMod(x, x, n)
+			composite = x != n-1
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
+
+// pollardRho returns a non-trivial factor of odd composite n.
+func pollardRho(n uint64) uint64 {
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 { return addMod(mulMod(x, x, n), c, n) }
+		x, y, d := uint64(2), uint64(2), uint64(1)
+		for d == 1 {
+			x, y = f(x), f(f(y))
+			d = GCD(max(x, y)-min(x, y), n)
+		}
+		if d != n {
+			return d
+		}
+	}
+}
+
+// Factor is a prime factor and its exponent.
+type Factor[T Integer] struct {
+	Prime T
+	Exp   int
+}
+
+// Factorize returns the prime factorization of n, ordered by ascending prime.
+//
+// Small factors are found by trial division, and the remaining ones by Pollard's rho algorithm.
+// It returns nil if n < 2.
+func Factorize[T Integer](n T) []Factor[T] {
+	if n < 2 {
+		return nil
+	}
+
+	m := uint64(n)
+	var primes []uint64
+	for p := uint64(2); p < 1000 && p*p <= m; p++ {
+		for m%p == 0 {
+			primes = append(primes, p)
+			m /= p
+		}
+	}
+
+	var split func(m uint64)
+	split = func(m uint64) {
+		if m == 1 {
+			return
+		}
+		if isPrime64(m) {
+			primes = append(primes, m)
+			return
+		}
+		d := pollardRho(m)
+		split(d)
+		split(m / d)
+	}
+	split(m)
+	slices.Sort(primes)
+
+	var ret []Factor[T]
+	for _, p := range primes {
+		if len(ret) > 0 && ret[len(ret)-1].Prime == T(p) {
+			ret[len(ret)-1].Exp++
+		} else {
+			ret = append(ret, Factor[T]{T(p), 1})
+		}
+	}
+	return ret
+}
+
+// Divisors returns all positive divisors of n in ascending order. It returns nil if n < 1.
+func Divisors[T Integer](n T) []T {
+	if n < 1 {
+		return nil
+	}
+	ret := []T{1}
+	for _, f := range Factorize(n) {
+		size := len(ret)
+		pow := T(1)
+		for e := 0; e < f.Exp; e++ {
+			pow *= f.Prime
+			for _, d := range ret[:size] {
+				ret = append(ret, d*pow)
+			}
+		}
+	}
+	slices.Sort(ret)
+	return ret
+}
+
+// Totient returns Euler's totient of n, the count of integers in [1, n] coprime with n.
+// It returns 0 if n < 1.
+func Totient[T Integer](n T) T {
+	if n < 1 {
+		return 0
+	}
+	ret := n
+	for _, f := range Factorize(n) {
+		ret = ret / f.Prime * (f.Prime - 1)
+	}
+	return ret
+}
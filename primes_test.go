@@ -0,0 +1,82 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestSieve(t *testing.T) {
+	if got, want := util.PrimesUpTo(30), []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PrimesUpTo(30) = %v, want %v", got, want)
+	}
+	if got, want := util.Sieve(3), []bool{false, false, true, true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sieve(3) = %v, want %v", got, want)
+	}
+	if got := util.PrimesUpTo(1); got != nil {
+		t.Errorf("PrimesUpTo(1) = %v, want nil", got)
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	sieve := util.Sieve(10000)
+	for i, want := range sieve {
+		if got := util.IsPrime(i); got != want {
+			t.Errorf("IsPrime(%d) = %v, want %v", i, got, want)
+		}
+	}
+
+	tests := []struct {
+		n    uint64
+		want bool
+	}{
+		{n: 3215031751, want: false},
+		{n: 1_000_000_007, want: true},
+		{n: 18446744073709551557, want: true},
+		{n: 18446744073709551615, want: false},
+		{n: 4294967291 * 4294967279, want: false},
+	}
+	for _, tt := range tests {
+		if got := util.IsPrime(tt.n); got != tt.want {
+			t.Errorf("IsPrime(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	if util.IsPrime(-7) {
+		t.Errorf("IsPrime(-7) = true, want false")
+	}
+}
+
+func TestFactorize(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want []util.Factor[uint64]
+	}{
+		{n: 1, want: nil},
+		{n: 360, want: []util.Factor[uint64]{{2, 3}, {3, 2}, {5, 1}}},
+		{n: 1_000_000_007, want: []util.Factor[uint64]{{1_000_000_007, 1}}},
+		{n: 4294967291 * 4294967279, want: []util.Factor[uint64]{{4294967279, 1}, {4294967291, 1}}},
+		{n: 999_983 * 999_983 * 17, want: []util.Factor[uint64]{{17, 1}, {999_983, 2}}},
+	}
+	for _, tt := range tests {
+		if got := util.Factorize(tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Factorize(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestDivisors(t *testing.T) {
+	if got, want := util.Divisors(36), []int{1, 2, 3, 4, 6, 9, 12, 18, 36}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Divisors(36) = %v, want %v", got, want)
+	}
+	if got, want := util.Divisors(1), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Divisors(1) = %v, want %v", got, want)
+	}
+
+	tests := []struct{ n, want int }{{1, 1}, {9, 6}, {36, 12}, {97, 96}, {100, 40}}
+	for _, tt := range tests {
+		if got := util.Totient(tt.n); got != tt.want {
+			t.Errorf("Totient(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrOverflow is the panic value, wrapped, of arithmetic operations whose result does not fit in the type.
+	ErrOverflow = errors.New("integer overflow")
+	// ErrDivisionByZero is the panic value, wrapped, of division by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+func minOf[T SignedInteger]() T {
+	return T(-1) << (bitSize[T]() - 1)
+}
+
+func negChecked[T SignedInteger](a T) T {
+	if a == minOf[T]() {
+		panic(fmt.Errorf("%w: -(%d)", ErrOverflow, a))
+	}
+	return -a
+}
+
+func addChecked[T SignedInteger](a, b T) T {
+	r := a + b
+	if (b > 0 && r < a) || (b < 0 && r > a) {
+		panic(fmt.Errorf("%w: %d + %d", ErrOverflow, a, b))
+	}
+	return r
+}
+
+func mulChecked[T SignedInteger](a, b T) T {
+	switch {
+	case a == 0 || b == 0:
+		return 0
+	case a == -1:
+		return negChecked(b)
+	case b == -1:
+		return negChecked(a)
+	}
+	r := a * b
+	if r/b != a {
+		panic(fmt.Errorf("%w: %d * %d", ErrOverflow, a, b))
+	}
+	return r
+}
+
+// Rational[T] is an exact fraction with numerator and denominator of type T.
+//
+// Values are always normalized: the denominator is positive and coprime with the numerator, so
+// rationals can be compared with == and used as map keys. The zero value is 0.
+//
+// Arithmetic operations panic with an error wrapping ErrOverflow if an intermediate result does not fit in T,
+// and with an error wrapping ErrDivisionByZero when dividing by zero.
+type Rational[T SignedInteger] struct {
+	num T
+	// denMinus1 is the denominator minus 1, making the zero value valid.
+	denMinus1 T
+}
+
+// NewRational returns the normalized fraction num/den.
+func NewRational[T SignedInteger](num, den T) Rational[T] {
+	if den == 0 {
+		panic(fmt.Errorf("%w: %d/%d", ErrDivisionByZero, num, den))
+	}
+	if g := Abs(GCD(Abs(num), Abs(den))); g != 0 {
+		num, den = num/g, den/g
+	}
+	if den < 0 {
+		num, den = negChecked(num), negChecked(den)
+	}
+	return Rational[T]{num, den - 1}
+}
+
+// RationalFromInt returns the rational n/1.
+func RationalFromInt[T SignedInteger](n T) Rational[T] {
+	return Rational[T]{n, 0}
+}
+
+// ParseRational parses a fraction in the form "a/b" or an integer "a".
+//
+// Numerator and denominator must fit in T, and so must the normalized fraction. Surrounding spaces are ignored.
+func ParseRational[T SignedInteger](s string) (Rational[T], error) {
+	numStr, denStr, hasDen := strings.Cut(s, "/")
+	num, err := Atoi[T](numStr)
+	if err != nil {
+		return Rational[T]{}, fmt.Errorf("invalid rational %q: %w", s, err)
+	}
+	if !hasDen {
+		return RationalFromInt(num), nil
+	}
+	den, err := Atoi[T](denStr)
+	if err != nil {
+		return Rational[T]{}, fmt.Errorf("invalid rational %q: %w", s, err)
+	}
+	var r Rational[T]
+	err = func() (err error) {
+		defer Recover(&err)
+		r = NewRational(num, den)
+		return nil
+	}()
+	if err != nil {
+		return Rational[T]{}, fmt.Errorf("invalid rational %q: %w", s, err)
+	}
+	return r, nil
+}
+
+// Num returns the numerator.
+func (r Rational[T]) Num() T {
+	return r.num
+}
+
+// Den returns the denominator, which is always positive.
+func (r Rational[T]) Den() T {
+	return r.denMinus1 + 1
+}
+
+// IsInt reports whether r is an integer.
+func (r Rational[T]) IsInt() bool {
+	return r.denMinus1 == 0
+}
+
+// Sign returns -1 if r < 0, 1 if r > 0, and 0 if r == 0.
+func (r Rational[T]) Sign() int {
+	return int(Sign(r.num))
+}
+
+// Neg returns -r.
+func (r Rational[T]) Neg() Rational[T] {
+	return Rational[T]{negChecked(r.num), r.denMinus1}
+}
+
+// Abs returns |r|.
+func (r Rational[T]) Abs() Rational[T] {
+	if r.num < 0 {
+		return r.Neg()
+	}
+	return r
+}
+
+// Inv returns 1/r.
+func (r Rational[T]) Inv() Rational[T] {
+	return NewRational(r.Den(), r.num)
+}
+
+// Add returns r + s.
+func (r Rational[T]) Add(s Rational[T]) Rational[T] {
+	g := GCD(r.Den(), s.Den())
+	return NewRational(
+		addChecked(mulChecked(r.num, s.Den()/g), mulChecked(s.num, r.Den()/g)),
+		mulChecked(r.Den()/g, s.Den()),
+	)
+}
+
+// Sub returns r - s.
+func (r Rational[T]) Sub(s Rational[T]) Rational[T] {
+	return r.Add(s.Neg())
+}
+
+// Mul returns r * s.
+func (r Rational[T]) Mul(s Rational[T]) Rational[T] {
+	if r.num == 0 || s.num == 0 {
+		return Rational[T]{}
+	}
+	g1, g2 := Abs(GCD(Abs(r.num), s.Den())), Abs(GCD(Abs(s.num), r.Den()))
+	return NewRational(mulChecked(r.num/g1, s.num/g2), mulChecked(r.Den()/g2, s.Den()/g1))
+}
+
+// Div returns r / s.
+func (r Rational[T]) Div(s Rational[T]) Rational[T] {
+	if s.num == 0 {
+		panic(fmt.Errorf("%w: %v / %v", ErrDivisionByZero, r, s))
+	}
+	return r.Mul(s.Inv())
+}
+
+// Cmp compares r and s, returning -1 if r < s, 0 if r == s, and 1 if r > s.
+//
+// Comparison never overflows.
+func (r Rational[T]) Cmp(s Rational[T]) int {
+	if rs, ss := r.Sign(), s.Sign(); rs != ss || rs == 0 {
+		return Sign(rs - ss)
+	}
+	abs := func(n T) uint64 {
+		if n < 0 {
+			return -uint64(int64(n))
+		}
+		return uint64(n)
+	}
+	lh, ll := bits.Mul64(abs(r.num), uint64(s.Den()))
+	rh, rl := bits.Mul64(abs(s.num), uint64(r.Den()))
+	cmp := 0
+	switch {
+	case lh < rh || lh == rh && ll < rl:
+		cmp = -1
+	case lh > rh || lh == rh && ll > rl:
+		cmp = 1
+	}
+	return cmp * r.Sign()
+}
+
+// Less reports whether r < s.
+func (r Rational[T]) Less(s Rational[T]) bool {
+	return r.Cmp(s) < 0
+}
+
+// Floor returns the greatest integer not greater than r.
+func (r Rational[T]) Floor() T {
+	q := r.num / r.Den()
+	if r.num%r.Den() < 0 {
+		q--
+	}
+	return q
+}
+
+// Ceil returns the least integer not less than r.
+func (r Rational[T]) Ceil() T {
+	q := r.num / r.Den()
+	if r.num%r.Den() > 0 {
+		q++
+	}
+	return q
+}
+
+// Float64 returns the nearest float64 value of r.
+func (r Rational[T]) Float64() float64 {
+	return float64(r.num) / float64(r.Den())
+}
+
+// String returns r in the form "a/b", or "a" if r is an integer.
+func (r Rational[T]) String() string {
+	if r.IsInt() {
+		return strconv.FormatInt(int64(r.num), 10)
+	}
+	return strconv.FormatInt(int64(r.num), 10) + "/" + strconv.FormatInt(int64(r.Den()), 10)
+}
@@ -0,0 +1,132 @@
+package util_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestNewRational(t *testing.T) {
+	tests := []struct {
+		num, den int64
+		want     string
+	}{
+		{num: 0, den: 5, want: "0"},
+		{num: 6, den: 4, want: "3/2"},
+		{num: 6, den: -4, want: "-3/2"},
+		{num: -6, den: -3, want: "2"},
+		{num: math.MinInt64, den: math.MinInt64, want: "1"},
+		{num: math.MinInt64, den: 6, want: "-4611686018427387904/3"},
+		{num: math.MinInt64, den: 5, want: "-9223372036854775808/5"},
+		{num: math.MinInt64, den: 7, want: "-9223372036854775808/7"},
+	}
+	for _, tt := range tests {
+		if got := util.NewRational(tt.num, tt.den).String(); got != tt.want {
+			t.Errorf("NewRational(%d, %d) = %s, want %s", tt.num, tt.den, got, tt.want)
+		}
+	}
+
+	for d := int8(1); d < math.MaxInt8; d += 2 {
+		if got := util.NewRational(int8(math.MinInt8), d); got.Num() != math.MinInt8 || got.Den() != d {
+			t.Errorf("NewRational(-128, %d) = %v", d, got)
+		}
+	}
+
+	if util.NewRational(2, 4) != util.NewRational(-1, -2) || util.NewRational(0, 3) != (util.Rational[int]{}) {
+		t.Errorf("NewRational() is not normalized")
+	}
+}
+
+func TestParseRational(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    string
+		wantErr bool
+		errIs   error
+	}{
+		{args: "3/4", want: "3/4"},
+		{args: " -10 / 4 ", want: "-5/2"},
+		{args: "7", want: "7"},
+		{args: "1/0", wantErr: true, errIs: util.ErrDivisionByZero},
+		{args: "1/-128", wantErr: true, errIs: util.ErrOverflow},
+		{args: "2/-128", want: "-1/64"},
+		{args: "-128/-1", wantErr: true, errIs: util.ErrOverflow},
+		{args: "300/1", wantErr: true},
+		{args: "a/b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			got, err := util.ParseRational[int8](tt.args)
+			if (err != nil) != tt.wantErr || tt.errIs != nil && !errors.Is(err, tt.errIs) {
+				t.Fatalf("ParseRational() error = %v, wantErr %v", err, tt.errIs)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseRational() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRationalArithmetic(t *testing.T) {
+	r := util.NewRational[int64]
+	a, b := r(1, 6), r(-3, 4)
+
+	tests := []struct {
+		name string
+		got  util.Rational[int64]
+		want util.Rational[int64]
+	}{
+		{name: "Add", got: a.Add(b), want: r(-7, 12)},
+		{name: "Sub", got: a.Sub(b), want: r(11, 12)},
+		{name: "Mul", got: a.Mul(b), want: r(-1, 8)},
+		{name: "Div", got: a.Div(b), want: r(-2, 9)},
+		{name: "Neg", got: b.Neg(), want: r(3, 4)},
+		{name: "Inv", got: b.Inv(), want: r(-4, 3)},
+		{name: "Abs", got: b.Abs(), want: r(3, 4)},
+		{name: "Large Mul", got: r(math.MaxInt64, 3).Mul(r(3, math.MaxInt64)), want: r(1, 1)},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+		}
+	}
+
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(r(2, 12)) != 0 || !b.Less(a) {
+		t.Errorf("Cmp() gives wrong result")
+	}
+	if r(math.MaxInt64, 2).Cmp(r(math.MaxInt64-1, 2)) != 1 || r(-math.MaxInt64, 3).Cmp(r(-math.MaxInt64, 2)) != 1 {
+		t.Errorf("Cmp() on large values gives wrong result")
+	}
+	if b.Floor() != -1 || b.Ceil() != 0 || r(7, 2).Floor() != 3 || r(7, 2).Ceil() != 4 || r(4, 2).Floor() != 2 {
+		t.Errorf("Floor() or Ceil() gives wrong result")
+	}
+	if !r(4, 2).IsInt() || a.IsInt() || a.Float64() != 1.0/6 || b.Sign() != -1 {
+		t.Errorf("IsInt(), Float64() or Sign() gives wrong result")
+	}
+}
+
+func TestRationalPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		exec func()
+		want error
+	}{
+		{name: "Add overflow", exec: func() { util.NewRational[int8](127, 1).Add(util.RationalFromInt[int8](1)) }, want: util.ErrOverflow},
+		{name: "Mul overflow", exec: func() { util.NewRational[int8](64, 1).Mul(util.RationalFromInt[int8](2)) }, want: util.ErrOverflow},
+		{name: "Neg overflow", exec: func() { util.RationalFromInt[int8](-128).Neg() }, want: util.ErrOverflow},
+		{name: "Zero denominator", exec: func() { util.NewRational(1, 0) }, want: util.ErrDivisionByZero},
+		{name: "Div by zero", exec: func() { util.RationalFromInt(1).Div(util.Rational[int]{}) }, want: util.ErrDivisionByZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if err, ok := recover().(error); !ok || !errors.Is(err, tt.want) {
+					t.Errorf("panicked with %v, want %v", err, tt.want)
+				}
+			}()
+			tt.exec()
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package region
+
+import (
+	"slices"
+
+	util "github.com/Xiangze-Li/golang-util"
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+// Point is a grid position. X is the row index `i`, Y is the column index `j`.
+type Point = util.Point2I[int]
+
+// Region is a connected set of grid cells holding the same value.
+type Region[T comparable] struct {
+	// ID is the label of the region, which is also its index in the slice returned by Label.
+	ID    int
+	Value T
+	// Cells are positions in the region, sorted in row-major order.
+	Cells []Point
+	// Min and Max are the top-left and bottom-right corners of the bounding box, both inclusive.
+	Min, Max Point
+	// Perimeter is the number of cell edges between the region and the outside.
+	Perimeter int
+	// Sides is the number of straight fence segments around the region, which equals the number of corners.
+	Sides int
+	// TouchesEdge is true if any cell of the region is on the border of the grid.
+	TouchesEdge bool
+}
+
+// Area returns the number of cells in the region.
+func (r Region[T]) Area() int {
+	return len(r.Cells)
+}
+
+func inGrid[T any](grid [][]T, p Point) bool {
+	return p.X >= 0 && p.X < len(grid) && p.Y >= 0 && p.Y < len(grid[p.X])
+}
+
+// Fill returns all cells connected to start that satisfy pred, in breadth-first order.
+//
+// Connectivity is given by deltas, usually c.Delta4 or c.Delta8. It returns nil if start is out of
+// the grid or does not satisfy pred.
+func Fill[T any](grid [][]T, start Point, deltas map[c.Direction][2]int, pred func(T) bool) []Point {
+	if !inGrid(grid, start) || !pred(grid[start.X][start.Y]) {
+		return nil
+	}
+
+	vis := map[Point]bool{start: true}
+	queue := []Point{start}
+	for i := 0; i < len(queue); i++ {
+		for _, d := range deltas {
+			q := queue[i].AddCord(d)
+			if inGrid(grid, q) && !vis[q] && pred(grid[q.X][q.Y]) {
+				vis[q] = true
+				queue = append(queue, q)
+			}
+		}
+	}
+	return queue
+}
+
+// Label finds all connected regions of equal values in grid.
+//
+// Connectivity is given by deltas, usually c.Delta4 or c.Delta8. Perimeter and sides are always
+// measured along the four orthogonal directions. The returned labels has the shape of grid, holding
+// the region ID of each cell. Regions are ordered by their first cell in row-major order.
+func Label[T comparable](grid [][]T, deltas map[c.Direction][2]int) ([][]int, []Region[T]) {
+	labels := make([][]int, len(grid))
+	for i := range grid {
+		labels[i] = make([]int, len(grid[i]))
+		for j := range labels[i] {
+			labels[i][j] = -1
+		}
+	}
+
+	var regions []Region[T]
+	for i := range grid {
+		for j := range grid[i] {
+			if labels[i][j] >= 0 {
+				continue
+			}
+			id, v := len(regions), grid[i][j]
+			cells := Fill(grid, Point{X: i, Y: j}, deltas, func(e T) bool { return e == v })
+			for _, p := range cells {
+				labels[p.X][p.Y] = id
+			}
+			regions = append(regions, measure(grid, labels, id, v, cells))
+		}
+	}
+	return labels, regions
+}
+
+func measure[T comparable](grid [][]T, labels [][]int, id int, v T, cells []Point) Region[T] {
+	slices.SortFunc(cells, func(l, r Point) int {
+		if l.Less(r) {
+			return -1
+		}
+		return 1
+	})
+	r := Region[T]{ID: id, Value: v, Cells: cells, Min: cells[0], Max: cells[0]}
+
+	in := func(p Point) bool { return inGrid(labels, p) && labels[p.X][p.Y] == id }
+	for _, p := range cells {
+		r.Min = Point{X: min(r.Min.X, p.X), Y: min(r.Min.Y, p.Y)}
+		r.Max = Point{X: max(r.Max.X, p.X), Y: max(r.Max.Y, p.Y)}
+		if p.X == 0 || p.X == len(grid)-1 || p.Y == 0 || p.Y == len(grid[p.X])-1 {
+			r.TouchesEdge = true
+		}
+
+		for _, d := range c.Delta4 {
+			if !in(p.AddCord(d)) {
+				r.Perimeter++
+			}
+		}
+		for _, d := range []c.Direction{c.NE, c.SE, c.SW, c.NW} {
+			vert, hori := in(p.AddCord(c.Delta4[d&(c.N|c.S)])), in(p.AddCord(c.Delta4[d&(c.E|c.W)]))
+			if !vert && !hori || vert && hori && !in(p.AddCord(c.Delta8[d])) {
+				r.Sides++
+			}
+		}
+	}
+	return r
+}
+
+// Enclosed finds cells that are enclosed by walls.
+//
+// A non-wall cell is enclosed if it cannot reach the border of the grid by moving orthogonally
+// through non-wall cells. Note that squeezing between adjacent walls is not possible, so inputs
+// where that is allowed should be scaled up first.
+func Enclosed[T any](grid [][]T, wall func(T) bool) [][]bool {
+	open := func(e T) bool { return !wall(e) }
+	outside := make(map[Point]bool)
+	for i := range grid {
+		for j := range grid[i] {
+			p := Point{X: i, Y: j}
+			if (i == 0 || i == len(grid)-1 || j == 0 || j == len(grid[i])-1) && !outside[p] {
+				for _, q := range Fill(grid, p, c.Delta4, open) {
+					outside[q] = true
+				}
+			}
+		}
+	}
+
+	ret := make([][]bool, len(grid))
+	for i := range grid {
+		ret[i] = make([]bool, len(grid[i]))
+		for j := range grid[i] {
+			ret[i][j] = !wall(grid[i][j]) && !outside[Point{X: i, Y: j}]
+		}
+	}
+	return ret
+}
@@ -0,0 +1,105 @@
+package region_test
+
+import (
+	"reflect"
+	"testing"
+
+	c "github.com/Xiangze-Li/golang-util/constants"
+	"github.com/Xiangze-Li/golang-util/region"
+)
+
+func toGrid(lines ...string) [][]byte {
+	grid := make([][]byte, len(lines))
+	for i, l := range lines {
+		grid[i] = []byte(l)
+	}
+	return grid
+}
+
+func TestLabel(t *testing.T) {
+	grid := toGrid(
+		"AAAA",
+		"BBCD",
+		"BBCC",
+		"EEEC",
+	)
+	labels, regions := region.Label(grid, c.Delta4)
+
+	wantLabels := [][]int{
+		{0, 0, 0, 0},
+		{1, 1, 2, 3},
+		{1, 1, 2, 2},
+		{4, 4, 4, 2},
+	}
+	if !reflect.DeepEqual(labels, wantLabels) {
+		t.Errorf("Label() labels = %v, want %v", labels, wantLabels)
+	}
+
+	tests := []struct {
+		value                  byte
+		area, perimeter, sides int
+		min, max               region.Point
+	}{
+		{value: 'A', area: 4, perimeter: 10, sides: 4, min: region.Point{X: 0, Y: 0}, max: region.Point{X: 0, Y: 3}},
+		{value: 'B', area: 4, perimeter: 8, sides: 4, min: region.Point{X: 1, Y: 0}, max: region.Point{X: 2, Y: 1}},
+		{value: 'C', area: 4, perimeter: 10, sides: 8, min: region.Point{X: 1, Y: 2}, max: region.Point{X: 3, Y: 3}},
+		{value: 'D', area: 1, perimeter: 4, sides: 4, min: region.Point{X: 1, Y: 3}, max: region.Point{X: 1, Y: 3}},
+		{value: 'E', area: 3, perimeter: 8, sides: 4, min: region.Point{X: 3, Y: 0}, max: region.Point{X: 3, Y: 2}},
+	}
+	if len(regions) != len(tests) {
+		t.Fatalf("Label() found %d regions, want %d", len(regions), len(tests))
+	}
+	for i, tt := range tests {
+		r := regions[i]
+		if r.Value != tt.value || r.Area() != tt.area || r.Perimeter != tt.perimeter || r.Sides != tt.sides ||
+			r.Min != tt.min || r.Max != tt.max {
+			t.Errorf("Label() region %d = %c area %d perimeter %d sides %d box %v-%v, want %c %d %d %d %v-%v",
+				i, r.Value, r.Area(), r.Perimeter, r.Sides, r.Min, r.Max,
+				tt.value, tt.area, tt.perimeter, tt.sides, tt.min, tt.max)
+		}
+	}
+}
+
+func TestLabelHole(t *testing.T) {
+	grid := toGrid(
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+		"OXOXO",
+		"OOOOO",
+	)
+	_, regions := region.Label(grid, c.Delta4)
+	if len(regions) != 5 {
+		t.Fatalf("Label() found %d regions, want 5", len(regions))
+	}
+	if r := regions[0]; r.Area() != 21 || r.Perimeter != 36 || r.Sides != 20 || !r.TouchesEdge {
+		t.Errorf("Label() outer region area %d perimeter %d sides %d edge %v, want 21 36 20 true",
+			r.Area(), r.Perimeter, r.Sides, r.TouchesEdge)
+	}
+	if regions[1].TouchesEdge {
+		t.Errorf("Label() inner region touches edge")
+	}
+
+	_, regions = region.Label(toGrid("X.", ".X"), c.Delta8)
+	if len(regions) != 2 || regions[0].Area() != 2 {
+		t.Errorf("Label() with Delta8 found %d regions, want 2", len(regions))
+	}
+}
+
+func TestEnclosed(t *testing.T) {
+	grid := toGrid(
+		".####.",
+		".#..#.",
+		".####.",
+		"......",
+	)
+	want := [][]bool{
+		{false, false, false, false, false, false},
+		{false, false, true, true, false, false},
+		{false, false, false, false, false, false},
+		{false, false, false, false, false, false},
+	}
+	if got := region.Enclosed(grid, func(b byte) bool { return b == '#' }); !reflect.DeepEqual(got, want) {
+		t.Errorf("Enclosed() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package util
+
+import (
+	"bufio"
+	"io"
+	"iter"
+	"os"
+)
+
+// ScanLines returns an iterator over lines read from r.
+//
+// Lines are read lazily as the iterator advances. If reading fails, the iterator panics with the error.
+func ScanLines(r io.Reader) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// LinesSeq returns an iterator over lines of file, the lazy version of GetLines.
+//
+// The file is opened when iteration starts and closed when it stops. If opening or reading fails,
+// the iterator panics with the error.
+func LinesSeq(filename string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		f := Must(os.Open(filename))
+		defer f.Close()
+
+		for line := range ScanLines(f) {
+			if !yield(line) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq reduces a sequence to a single value using a given function, like Reduce.
+func ReduceSeq[E any, R any](seq iter.Seq[E], f func(R, E) R, init R) R {
+	for e := range seq {
+		init = f(init, e)
+	}
+	return init
+}
+
+// ReduceSeq2 reduces a sequence of pairs to a single value using a given function, like ReduceMap.
+func ReduceSeq2[K any, V any, R any](seq iter.Seq2[K, V], f func(R, K, V) R, init R) R {
+	for k, v := range seq {
+		init = f(init, k, v)
+	}
+	return init
+}
+
+// MapSeq returns an iterator converting each element of seq using a given function.
+func MapSeq[E any, R any](seq iter.Seq[E], f func(E) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for e := range seq {
+			if !yield(f(e)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iterator over elements of seq for which f returns true.
+func FilterSeq[E any](seq iter.Seq[E], f func(E) bool) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for e := range seq {
+			if f(e) && !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns an iterator over leading elements of seq for which f returns true.
+//
+// It stops at the first element for which f returns false.
+func TakeWhile[E any](seq iter.Seq[E], f func(E) bool) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for e := range seq {
+			if !f(e) || !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over elements of seq paired with their indices.
+func Enumerate[E any](seq iter.Seq[E]) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		i := 0
+		for e := range seq {
+			if !yield(i, e) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package util_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestScanLines(t *testing.T) {
+	got := slices.Collect(util.ScanLines(strings.NewReader("a\nbb\n\nccc")))
+	if want := []string{"a", "bb", "", "ccc"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanLines() = %q, want %q", got, want)
+	}
+}
+
+func TestLinesSeq(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(name, []byte("1\n2\n3\n\n4\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := util.LinesSeq(name)
+	if got, want := slices.Collect(lines), util.GetLines(name); !reflect.DeepEqual(got, want) {
+		t.Errorf("LinesSeq() = %q, want %q", got, want)
+	}
+
+	nonEmpty := util.TakeWhile(lines, func(s string) bool { return s != "" })
+	nums := util.MapSeq(nonEmpty, func(s string) int { return util.Must(strconv.Atoi(s)) })
+	if got := util.ReduceSeq(nums, func(acc, n int) int { return acc + n }, 0); got != 6 {
+		t.Errorf("ReduceSeq() = %d, want 6", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("LinesSeq() on missing file did not panic")
+		}
+	}()
+	for range util.LinesSeq(filepath.Join(t.TempDir(), "missing.txt")) {
+		t.Errorf("LinesSeq() on missing file yields a line")
+	}
+}
+
+func TestSeqHelpers(t *testing.T) {
+	seq := slices.Values([]int{1, 2, 3, 4, 5, 6})
+	isOdd := func(i int) bool { return i%2 == 1 }
+
+	if got, want := slices.Collect(util.FilterSeq(seq, isOdd)), []int{1, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterSeq() = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(util.TakeWhile(seq, func(i int) bool { return i < 4 })), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhile() = %v, want %v", got, want)
+	}
+	if got, want := slices.Collect(util.MapSeq(seq, strconv.Itoa)), []string{"1", "2", "3", "4", "5", "6"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MapSeq() = %v, want %v", got, want)
+	}
+
+	var got [][2]int
+	for i, e := range util.Enumerate(util.FilterSeq(seq, isOdd)) {
+		got = append(got, [2]int{i, e})
+		if i == 1 {
+			break
+		}
+	}
+	if want := [][2]int{{0, 1}, {1, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Enumerate() = %v, want %v", got, want)
+	}
+
+	sum := util.ReduceSeq2(util.Enumerate(seq), func(acc, i, e int) int { return acc + i*e }, 0)
+	if sum != 70 {
+		t.Errorf("ReduceSeq2() = %d, want 70", sum)
+	}
+}
@@ -0,0 +1,154 @@
+package util
+
+import (
+	"iter"
+	"maps"
+
+	c "github.com/Xiangze-Li/golang-util/constants"
+)
+
+// SparseGrid[T] is an unbounded 2D grid storing only cells that are set.
+//
+// Positions follow the convention of GetGrid: X is the row index `i` growing South, Y is the column
+// index `j` growing East. Cells that are not set read as the default value. The zero value is not
+// usable, call NewSparseGrid or SparseGridFrom to create one.
+type SparseGrid[T any] struct {
+	cells    map[Point2I[int]]T
+	def      T
+	min, max Point2I[int]
+	// stale is set when a deletion may have shrunk the bounding box.
+	stale bool
+}
+
+// NewSparseGrid creates an empty sparse grid with default value def.
+func NewSparseGrid[T any](def T) *SparseGrid[T] {
+	return &SparseGrid[T]{cells: make(map[Point2I[int]]T), def: def}
+}
+
+// SparseGridFrom creates a sparse grid from a dense one, such as one read by GetGrid.
+//
+// Only cells satisfying keep are set, others read as def.
+func SparseGridFrom[T any](grid [][]T, def T, keep func(T) bool) *SparseGrid[T] {
+	g := NewSparseGrid(def)
+	for i, row := range grid {
+		for j, e := range row {
+			if keep(e) {
+				g.Set(Point2I[int]{i, j}, e)
+			}
+		}
+	}
+	return g
+}
+
+// Default returns the value of cells that are not set.
+func (g *SparseGrid[T]) Default() T {
+	return g.def
+}
+
+// Len returns the number of cells set.
+func (g *SparseGrid[T]) Len() int {
+	return len(g.cells)
+}
+
+// Get returns the value at p, or the default value if p is not set.
+func (g *SparseGrid[T]) Get(p Point2I[int]) T {
+	if v, ok := g.cells[p]; ok {
+		return v
+	}
+	return g.def
+}
+
+// Lookup returns the value at p, and whether p is set.
+func (g *SparseGrid[T]) Lookup(p Point2I[int]) (T, bool) {
+	v, ok := g.cells[p]
+	return v, ok
+}
+
+// Set sets the value at p.
+func (g *SparseGrid[T]) Set(p Point2I[int], v T) {
+	if len(g.cells) == 0 && !g.stale {
+		g.min, g.max = p, p
+	} else if !g.stale {
+		g.min = Point2I[int]{min(g.min.X, p.X), min(g.min.Y, p.Y)}
+		g.max = Point2I[int]{max(g.max.X, p.X), max(g.max.Y, p.Y)}
+	}
+	g.cells[p] = v
+}
+
+// Delete unsets p, which reads as the default value afterwards.
+func (g *SparseGrid[T]) Delete(p Point2I[int]) {
+	if _, ok := g.cells[p]; !ok {
+		return
+	}
+	delete(g.cells, p)
+	if p.X == g.min.X || p.X == g.max.X || p.Y == g.min.Y || p.Y == g.max.Y {
+		g.stale = true
+	}
+}
+
+// Bounds returns the top-left and bottom-right corners, both inclusive, of the bounding box of all
+// cells set. ok is false if no cell is set.
+func (g *SparseGrid[T]) Bounds() (lo, hi Point2I[int], ok bool) {
+	if len(g.cells) == 0 {
+		return Point2I[int]{}, Point2I[int]{}, false
+	}
+	if g.stale {
+		first := true
+		for p := range g.cells {
+			if first {
+				g.min, g.max, first = p, p, false
+				continue
+			}
+			g.min = Point2I[int]{min(g.min.X, p.X), min(g.min.Y, p.Y)}
+			g.max = Point2I[int]{max(g.max.X, p.X), max(g.max.Y, p.Y)}
+		}
+		g.stale = false
+	}
+	return g.min, g.max, true
+}
+
+// All returns an iterator over all cells set, in unspecified order.
+//
+// Cells must not be set or deleted during iteration.
+func (g *SparseGrid[T]) All() iter.Seq2[Point2I[int], T] {
+	return maps.All(g.cells)
+}
+
+// Clone returns a copy of g.
+func (g *SparseGrid[T]) Clone() *SparseGrid[T] {
+	ret := *g
+	ret.cells = maps.Clone(g.cells)
+	return &ret
+}
+
+// CountNeighbors returns the number of the 8 neighbors of p whose value satisfies pred.
+//
+// Cells not set are tested with the default value.
+func (g *SparseGrid[T]) CountNeighbors(p Point2I[int], pred func(T) bool) int {
+	count := 0
+	for _, d := range c.Delta8 {
+		if pred(g.Get(p.AddCord(d))) {
+			count++
+		}
+	}
+	return count
+}
+
+// Render converts the bounding box of g into a dense grid, like one read by GetGrid.
+//
+// Each cell is converted to a byte by f, cells not set are converted from the default value.
+// It returns an empty grid if no cell is set.
+func (g *SparseGrid[T]) Render(f func(T) byte) [][]byte {
+	lo, hi, ok := g.Bounds()
+	if !ok {
+		return [][]byte{}
+	}
+	ret := make([][]byte, hi.X-lo.X+1)
+	for i := range ret {
+		ret[i] = make([]byte, hi.Y-lo.Y+1)
+		for j := range ret[i] {
+			ret[i][j] = f(g.Get(Point2I[int]{lo.X + i, lo.Y + j}))
+		}
+	}
+	return ret
+}
@@ -0,0 +1,72 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	util "github.com/Xiangze-Li/golang-util"
+)
+
+func TestSparseGrid(t *testing.T) {
+	type pt = util.Point2I[int]
+	grid := [][]byte{
+		[]byte(".#."),
+		[]byte("..#"),
+		[]byte("###"),
+	}
+	isLive := func(b byte) bool { return b == '#' }
+	g := util.SparseGridFrom(grid, '.', isLive)
+
+	if g.Len() != 5 || g.Get(pt{0, 1}) != '#' || g.Get(pt{-5, 9}) != '.' {
+		t.Errorf("SparseGridFrom() gives wrong cells")
+	}
+	if _, ok := g.Lookup(pt{0, 0}); ok {
+		t.Errorf("Lookup(0, 0) reports set, want unset")
+	}
+	if lo, hi, ok := g.Bounds(); !ok || lo != (pt{0, 0}) || hi != (pt{2, 2}) {
+		t.Errorf("Bounds() = %v, %v, %v, want (0, 0), (2, 2), true", lo, hi, ok)
+	}
+	if got := g.CountNeighbors(pt{1, 1}, isLive); got != 5 {
+		t.Errorf("CountNeighbors(1, 1) = %d, want 5", got)
+	}
+	if got := g.CountNeighbors(pt{3, 1}, isLive); got != 3 {
+		t.Errorf("CountNeighbors(3, 1) = %d, want 3", got)
+	}
+
+	clone := g.Clone()
+	g.Set(pt{-1, 4}, '#')
+	if lo, hi, _ := g.Bounds(); lo != (pt{-1, 0}) || hi != (pt{2, 4}) {
+		t.Errorf("Bounds() after Set = %v, %v, want (-1, 0), (2, 4)", lo, hi)
+	}
+	g.Delete(pt{-1, 4})
+	g.Delete(pt{2, 0})
+	g.Delete(pt{2, 1})
+	g.Delete(pt{2, 2})
+	if lo, hi, _ := g.Bounds(); lo != (pt{0, 1}) || hi != (pt{1, 2}) {
+		t.Errorf("Bounds() after Delete = %v, %v, want (0, 1), (1, 2)", lo, hi)
+	}
+
+	render := func(b byte) byte { return b }
+	if got, want := g.Render(render), [][]byte{[]byte("#."), []byte(".#")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	if got := clone.Render(render); !reflect.DeepEqual(got, grid) {
+		t.Errorf("Clone().Render() = %q, want %q", got, grid)
+	}
+
+	count := 0
+	for p, v := range clone.All() {
+		if grid[p.X][p.Y] != v {
+			t.Errorf("All() yields %v at %v, want %v", v, p, grid[p.X][p.Y])
+		}
+		count++
+	}
+	if count != 5 {
+		t.Errorf("All() yields %d cells, want 5", count)
+	}
+
+	empty := util.NewSparseGrid(0)
+	if _, _, ok := empty.Bounds(); ok || len(empty.Render(func(int) byte { return '.' })) != 0 {
+		t.Errorf("empty SparseGrid has bounds")
+	}
+}
@@ -0,0 +1,222 @@
+package unionfind
+
+// Indexed is a disjoint set over integers 0, 1, ..., n-1.
+//
+// It uses path compression and union by rank, making every operation effectively constant time.
+type Indexed struct {
+	parent []int
+	rank   []uint8
+	size   []int
+	count  int
+}
+
+// NewIndexed creates a disjoint set of n singletons 0, 1, ..., n-1.
+func NewIndexed(n int) *Indexed {
+	s := &Indexed{}
+	s.Grow(n)
+	return s
+}
+
+// Grow adds singletons until the set holds n elements. It does nothing if the set is large enough.
+func (s *Indexed) Grow(n int) {
+	for i := len(s.parent); i < n; i++ {
+		s.parent = append(s.parent, i)
+		s.rank = append(s.rank, 0)
+		s.size = append(s.size, 1)
+		s.count++
+	}
+}
+
+// Len returns the number of elements.
+func (s *Indexed) Len() int {
+	return len(s.parent)
+}
+
+// Count returns the number of components.
+func (s *Indexed) Count() int {
+	return s.count
+}
+
+// Find returns the representative of the component containing x.
+func (s *Indexed) Find(x int) int {
+	root := x
+	for s.parent[root] != root {
+		root = s.parent[root]
+	}
+	for s.parent[x] != root {
+		s.parent[x], x = root, s.parent[x]
+	}
+	return root
+}
+
+// Union merges the components containing a and b.
+//
+// It returns false if a and b were already in the same component.
+func (s *Indexed) Union(a, b int) bool {
+	a, b = s.Find(a), s.Find(b)
+	if a == b {
+		return false
+	}
+	if s.rank[a] < s.rank[b] {
+		a, b = b, a
+	}
+	if s.rank[a] == s.rank[b] {
+		s.rank[a]++
+	}
+	s.parent[b] = a
+	s.size[a] += s.size[b]
+	s.count--
+	return true
+}
+
+// Connected reports whether a and b are in the same component.
+func (s *Indexed) Connected(a, b int) bool {
+	return s.Find(a) == s.Find(b)
+}
+
+// Size returns the number of elements in the component containing x.
+func (s *Indexed) Size(x int) int {
+	return s.size[s.Find(x)]
+}
+
+// Sizes returns the sizes of all components, ordered by their smallest element.
+func (s *Indexed) Sizes() []int {
+	sizes := make([]int, 0, s.count)
+	for _, c := range s.Components() {
+		sizes = append(sizes, len(c))
+	}
+	return sizes
+}
+
+// Components returns all components.
+//
+// Components are ordered by their smallest element, and elements in each component are ascending.
+func (s *Indexed) Components() [][]int {
+	idx := make(map[int]int, s.count)
+	comps := make([][]int, 0, s.count)
+	for x := range s.parent {
+		root := s.Find(x)
+		i, ok := idx[root]
+		if !ok {
+			i = len(comps)
+			idx[root] = i
+			comps = append(comps, make([]int, 0, s.size[root]))
+		}
+		comps[i] = append(comps[i], x)
+	}
+	return comps
+}
+
+// DisjointSet is a disjoint set over arbitrary comparable elements.
+//
+// Elements are added on first use. The zero value is not usable, call New to create one.
+type DisjointSet[T comparable] struct {
+	set   *Indexed
+	index map[T]int
+	items []T
+}
+
+// New creates a disjoint set holding the given elements as singletons.
+func New[T comparable](items ...T) *DisjointSet[T] {
+	s := &DisjointSet[T]{
+		set:   NewIndexed(0),
+		index: make(map[T]int, len(items)),
+	}
+	for _, x := range items {
+		s.Add(x)
+	}
+	return s
+}
+
+// Add adds x as a singleton. It does nothing if x is already present.
+func (s *DisjointSet[T]) Add(x T) {
+	s.id(x)
+}
+
+func (s *DisjointSet[T]) id(x T) int {
+	if i, ok := s.index[x]; ok {
+		return i
+	}
+	i := len(s.items)
+	s.index[x] = i
+	s.items = append(s.items, x)
+	s.set.Grow(i + 1)
+	return i
+}
+
+// Has reports whether x has been added.
+func (s *DisjointSet[T]) Has(x T) bool {
+	_, ok := s.index[x]
+	return ok
+}
+
+// Len returns the number of elements.
+func (s *DisjointSet[T]) Len() int {
+	return len(s.items)
+}
+
+// Count returns the number of components.
+func (s *DisjointSet[T]) Count() int {
+	return s.set.Count()
+}
+
+// Find returns the representative of the component containing x.
+//
+// If x has not been added, it is treated as a singleton and returned as is.
+func (s *DisjointSet[T]) Find(x T) T {
+	i, ok := s.index[x]
+	if !ok {
+		return x
+	}
+	return s.items[s.set.Find(i)]
+}
+
+// Union merges the components containing a and b.
+//
+// It returns false if a and b were already in the same component.
+func (s *DisjointSet[T]) Union(a, b T) bool {
+	return s.set.Union(s.id(a), s.id(b))
+}
+
+// Connected reports whether a and b are in the same component.
+//
+// An element that has not been added is treated as a singleton, so it is only connected to itself.
+func (s *DisjointSet[T]) Connected(a, b T) bool {
+	i, ok := s.index[a]
+	j, ok2 := s.index[b]
+	if !ok || !ok2 {
+		return a == b
+	}
+	return s.set.Connected(i, j)
+}
+
+// Size returns the number of elements in the component containing x.
+//
+// If x has not been added, it is treated as a singleton and 1 is returned.
+func (s *DisjointSet[T]) Size(x T) int {
+	i, ok := s.index[x]
+	if !ok {
+		return 1
+	}
+	return s.set.Size(i)
+}
+
+// Sizes returns the sizes of all components, in the same order as Components.
+func (s *DisjointSet[T]) Sizes() []int {
+	return s.set.Sizes()
+}
+
+// Components returns all components.
+//
+// Components and elements in each component are ordered by the time they were first added.
+func (s *DisjointSet[T]) Components() [][]T {
+	comps := make([][]T, 0, s.set.Count())
+	for _, c := range s.set.Components() {
+		items := make([]T, len(c))
+		for i, x := range c {
+			items[i] = s.items[x]
+		}
+		comps = append(comps, items)
+	}
+	return comps
+}
@@ -0,0 +1,82 @@
+package unionfind_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Xiangze-Li/golang-util/unionfind"
+)
+
+func TestIndexed(t *testing.T) {
+	s := unionfind.NewIndexed(6)
+	if got := s.Count(); got != 6 {
+		t.Fatalf("Count() = %d, want 6", got)
+	}
+
+	tests := []struct {
+		a, b int
+		want bool
+	}{
+		{a: 0, b: 1, want: true},
+		{a: 2, b: 3, want: true},
+		{a: 1, b: 3, want: true},
+		{a: 0, b: 2, want: false},
+		{a: 4, b: 4, want: false},
+	}
+	for _, tt := range tests {
+		if got := s.Union(tt.a, tt.b); got != tt.want {
+			t.Errorf("Union(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if !s.Connected(0, 3) || s.Connected(0, 4) {
+		t.Errorf("Connected() gives wrong result")
+	}
+	if got := s.Size(2); got != 4 {
+		t.Errorf("Size(2) = %d, want 4", got)
+	}
+	if got, want := s.Components(), [][]int{{0, 1, 2, 3}, {4}, {5}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+	if got, want := s.Sizes(), []int{4, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sizes() = %v, want %v", got, want)
+	}
+
+	s.Grow(7)
+	if s.Len() != 7 || s.Count() != 4 {
+		t.Errorf("Grow(7) gives Len() = %d, Count() = %d, want 7, 4", s.Len(), s.Count())
+	}
+}
+
+func TestDisjointSet(t *testing.T) {
+	s := unionfind.New("a", "b", "c")
+	s.Union("a", "x")
+	s.Union("y", "b")
+	s.Union("x", "y")
+
+	if got := s.Count(); got != 2 {
+		t.Errorf("Count() = %d, want 2", got)
+	}
+	if !s.Connected("a", "b") || s.Connected("a", "c") {
+		t.Errorf("Connected() gives wrong result")
+	}
+	if got := s.Find("b"); got != s.Find("x") {
+		t.Errorf("Find(b) = %q, want same as Find(x) = %q", got, s.Find("x"))
+	}
+	if got := s.Size("y"); got != 4 {
+		t.Errorf("Size(y) = %d, want 4", got)
+	}
+	if got, want := s.Components(), [][]string{{"a", "b", "x", "y"}, {"c"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Components() = %v, want %v", got, want)
+	}
+	if s.Has("z") {
+		t.Errorf("Has(z) = true, want false")
+	}
+
+	if s.Connected("a", "z") || !s.Connected("z", "z") || s.Find("z") != "z" || s.Size("z") != 1 {
+		t.Errorf("queries on unknown element give wrong result")
+	}
+	if s.Has("z") || s.Len() != 5 || s.Count() != 2 {
+		t.Errorf("queries on unknown element modified the set")
+	}
+}